@@ -23,16 +23,20 @@ import (
 	"fmt"
 	"net/http"
 	"strings"
+	gotime "time"
 
 	"connectrpc.com/connect"
 	"go.uber.org/zap"
 	"golang.org/x/net/http2"
+	"google.golang.org/protobuf/types/known/timestamppb"
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/api/yorkie/v1/v1connect"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 )
 
@@ -362,6 +366,204 @@ func (c *Client) ListChangeSummaries(
 	return summaries, nil
 }
 
+// GetDocumentAtRevision returns the document of the given key at the given
+// revision. If the given time is not zero, the revision is the last change
+// stored at or before the time. Otherwise, the revision is the given server
+// sequence.
+func (c *Client) GetDocumentAtRevision(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	serverSeq int64,
+	at gotime.Time,
+) (*types.DocumentRevision, error) {
+	req := &api.GetDocumentAtRevisionRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		ServerSeq:   serverSeq,
+	}
+	if !at.IsZero() {
+		req.Timestamp = timestamppb.New(at)
+	}
+
+	resp, err := c.client.GetDocumentAtRevision(ctx, connect.NewRequest(req))
+	if err != nil {
+		return nil, err
+	}
+
+	presences := make(map[string]innerpresence.Presence)
+	for id, pbPresence := range resp.Msg.Presences {
+		presences[id] = pbPresence.GetData()
+	}
+
+	return &types.DocumentRevision{
+		Key:       key,
+		ServerSeq: resp.Msg.ServerSeq,
+		Root:      resp.Msg.Root,
+		Presences: presences,
+	}, nil
+}
+
+// DiffDocument returns the differences of the document of the given key
+// between the given two revisions.
+func (c *Client) DiffDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	fromSeq int64,
+	toSeq int64,
+) ([]*crdt.Difference, error) {
+	resp, err := c.client.DiffDocument(ctx, connect.NewRequest(&api.DiffDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		FromSeq:     fromSeq,
+		ToSeq:       toSeq,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentDiffs(resp.Msg.Differences), nil
+}
+
+// CreateRevisionTag creates a tag of the given name that points to the given
+// server sequence of the document.
+func (c *Client) CreateRevisionTag(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	name string,
+	serverSeq int64,
+	message string,
+) (*types.RevisionTag, error) {
+	resp, err := c.client.CreateRevisionTag(ctx, connect.NewRequest(&api.CreateRevisionTagRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		Name:        name,
+		ServerSeq:   serverSeq,
+		Message:     message,
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRevisionTag(resp.Msg.Tag), nil
+}
+
+// ListRevisionTags lists the tags of the document of the given key.
+func (c *Client) ListRevisionTags(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) ([]*types.RevisionTag, error) {
+	resp, err := c.client.ListRevisionTags(ctx, connect.NewRequest(&api.ListRevisionTagsRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromRevisionTags(resp.Msg.Tags), nil
+}
+
+// DeleteRevisionTag deletes the tag of the given name of the document.
+func (c *Client) DeleteRevisionTag(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	name string,
+) error {
+	_, err := c.client.DeleteRevisionTag(ctx, connect.NewRequest(&api.DeleteRevisionTagRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+		Name:        name,
+	}))
+	return err
+}
+
+// ForkDocument creates a new document of the given target key with the root
+// of the document at the given server sequence. If the target project name is
+// empty, the new document is created in the same project.
+func (c *Client) ForkDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	serverSeq int64,
+	targetProjectName string,
+	targetKey key.Key,
+) (*types.DocumentSummary, error) {
+	if targetProjectName == "" {
+		targetProjectName = projectName
+	}
+	project, err := c.GetProject(ctx, targetProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.ForkDocument(
+		ctx,
+		withShardKey(connect.NewRequest(&api.ForkDocumentRequest{
+			ProjectName:       projectName,
+			DocumentKey:       key.String(),
+			ServerSeq:         serverSeq,
+			TargetProjectName: targetProjectName,
+			TargetDocumentKey: targetKey.String(),
+		}), project.PublicKey, targetKey.String()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentSummary(resp.Msg.Document), nil
+}
+
+// RestoreDocument restores the document of the given key to the root at the
+// given server sequence.
+func (c *Client) RestoreDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+	serverSeq int64,
+) (*types.DocumentSummary, error) {
+	project, err := c.GetProject(ctx, projectName)
+	if err != nil {
+		return nil, err
+	}
+
+	resp, err := c.client.RestoreDocument(
+		ctx,
+		withShardKey(connect.NewRequest(&api.RestoreDocumentRequest{
+			ProjectName: projectName,
+			DocumentKey: key.String(),
+			ServerSeq:   serverSeq,
+		}), project.PublicKey, key.String()),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentSummary(resp.Msg.Document), nil
+}
+
+// BlameDocument returns the attributions of the elements and the characters
+// of the document of the given key.
+func (c *Client) BlameDocument(
+	ctx context.Context,
+	projectName string,
+	key key.Key,
+) ([]*crdt.Attribution, error) {
+	resp, err := c.client.BlameDocument(ctx, connect.NewRequest(&api.BlameDocumentRequest{
+		ProjectName: projectName,
+		DocumentKey: key.String(),
+	}))
+	if err != nil {
+		return nil, err
+	}
+
+	return converter.FromDocumentAttributions(resp.Msg.Attributions), nil
+}
+
 // GetServerVersion gets the server version.
 func (c *Client) GetServerVersion(ctx context.Context) (*types.VersionDetail, error) {
 	response, err := c.client.GetServerVersion(ctx, connect.NewRequest(&api.GetServerVersionRequest{}))
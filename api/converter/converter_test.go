@@ -204,6 +204,68 @@ func TestConverter(t *testing.T) {
 		assert.ErrorIs(t, err, converter.ErrCheckpointRequired)
 	})
 
+	t.Run("remove style change pack test", func(t *testing.T) {
+		d1 := document.New("d1")
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").
+				Edit(0, 0, "Hello World", nil).
+				Style(0, 5, map[string]string{"b": "1"}).
+				RemoveStyle(0, 2, []string{"b"})
+			return nil
+		}))
+		assert.Equal(t, `{"k1":[{"val":"He"},{"attrs":{"b":"1"},"val":"llo"},{"val":" World"}]}`, d1.Marshal())
+
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+
+		d2 := document.New("d1")
+		assert.NoError(t, d2.ApplyChangePack(pack))
+		assert.Equal(t, d1.Marshal(), d2.Marshal())
+	})
+
+	t.Run("tree move change pack test", func(t *testing.T) {
+		d1 := document.New("d1")
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{
+				Type: "r",
+				Children: []json.TreeNode{
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "hello"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "world"}}},
+				},
+			})
+			root.GetTree("t").Move([]int{0}, []int{2})
+			return nil
+		}))
+		assert.Equal(t, "<r><p>world</p><p>hello</p></r>", d1.Root().GetTree("t").ToXML())
+
+		pbPack, err := converter.ToChangePack(d1.CreateChangePack())
+		assert.NoError(t, err)
+		pack, err := converter.FromChangePack(pbPack)
+		assert.NoError(t, err)
+
+		d2 := document.New("d1")
+		assert.NoError(t, d2.ApplyChangePack(pack))
+		assert.Equal(t, d1.Root().GetTree("t").ToXML(), d2.Root().GetTree("t").ToXML())
+
+		// NOTE: The slot of the move should be kept in the snapshot to resolve
+		// the concurrent moves later, and the moved node keeps its identity.
+		tree := d1.Root().GetTree("t").Tree
+		bytes, err := converter.TreeToBytes(tree)
+		assert.NoError(t, err)
+		clone, err := converter.BytesToTree(bytes)
+		assert.NoError(t, err)
+		moved := clone.Root().Index.Children()[1].Value
+		assert.Equal(t, tree.Root().Index.Children()[1].Value.IDString(), moved.IDString())
+		assert.NotNil(t, moved.MovedAt())
+		assert.Equal(t, tree.Root().Index.Children()[1].Value.MovedAt().Key(), moved.MovedAt().Key())
+
+		slot := clone.Root().Index.Children(true)[0].Value
+		assert.True(t, slot.IsRemoved())
+		assert.True(t, moved.ID().Equals(slot.MovedNodeID))
+	})
+
 	t.Run("tree converting test", func(t *testing.T) {
 		root := helper.BuildTreeNode(&json.TreeNode{
 			Type: "r",
@@ -301,4 +363,48 @@ func TestConverter(t *testing.T) {
 		assert.Equal(t, obj.Get("t").(*crdt.Tree).Root().Len(), doc.Root().GetTree("t").Len())
 		assert.Equal(t, obj.Get("t").(*crdt.Tree).ToXML(), doc.Root().GetTree("t").ToXML())
 	})
+
+	t.Run("snapshot to delta test", func(t *testing.T) {
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("note").SetNewText("content").
+				Edit(0, 0, "Hello\n").
+				Style(0, 5, map[string]string{"bold": "true"})
+			root.SetString("title", "hi")
+			return nil
+		}))
+
+		snapshot, err := converter.SnapshotToBytes(doc.RootObject(), doc.AllPresences())
+		assert.NoError(t, err)
+		delta, err := converter.SnapshotToDelta(snapshot, "$.note.content")
+		assert.NoError(t, err)
+		assert.Equal(t, []json.DeltaOp{
+			{Insert: "Hello", Attributes: map[string]any{"bold": "true"}},
+			{Insert: "\n"},
+		}, delta)
+
+		_, err = converter.SnapshotToDelta(snapshot, "$.title")
+		assert.ErrorIs(t, err, converter.ErrUnsupportedElement)
+		_, err = converter.SnapshotToDelta(snapshot, "$.unknown")
+		assert.ErrorIs(t, err, converter.ErrUnsupportedElement)
+	})
+
+	t.Run("properly encode and decode text test", func(t *testing.T) {
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("t").
+				Edit(0, 0, "Hello", map[string]string{"b": "1", "i": "1"}).
+				RemoveStyle(0, 5, []string{"i"})
+			return nil
+		}))
+		assert.Equal(t, `{"t":[{"attrs":{"b":"1"},"val":"Hello"}]}`, doc.Marshal())
+
+		bytes, err := converter.ObjectToBytes(doc.RootObject())
+		assert.NoError(t, err)
+		obj, err := converter.BytesToObject(bytes)
+		assert.NoError(t, err)
+
+		assert.Equal(t, doc.Marshal(), obj.Marshal())
+		assert.Len(t, obj.Get("t").(*crdt.Text).GCPairs(), 1)
+	})
 }
@@ -25,6 +25,7 @@ import (
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -105,6 +106,27 @@ func BytesToTree(snapshot []byte) (*crdt.Tree, error) {
 	return tree, nil
 }
 
+// SnapshotToDelta returns the Quill Delta of the text of the given path, e.g.
+// "$.content", in the given snapshot.
+func SnapshotToDelta(snapshot []byte, path string) ([]json.DeltaOp, error) {
+	if _, err := crdt.SplitPath(path); err != nil {
+		return nil, err
+	}
+
+	obj, _, err := BytesToSnapshot(snapshot)
+	if err != nil {
+		return nil, err
+	}
+
+	elem := json.NewObject(nil, obj).GetByPath(path)
+	text, ok := elem.(*json.Text)
+	if !ok {
+		return nil, fmt.Errorf("%s is not a text: %w", path, ErrUnsupportedElement)
+	}
+
+	return text.ToDelta(), nil
+}
+
 func fromJSONElement(pbElem *api.JSONElement) (crdt.Element, error) {
 	switch decoded := pbElem.Body.(type) {
 	case *api.JSONElement_JsonObject:
@@ -322,7 +344,7 @@ func fromTextNode(
 		if err != nil {
 			return nil, err
 		}
-		attrs.Set(key, pbAttr.Value, updatedAt)
+		attrs.SetInternal(key, pbAttr.Value, updatedAt, pbAttr.IsRemoved)
 	}
 
 	textNode := crdt.NewRGATreeSplitNode(
@@ -58,6 +58,7 @@ func FromProject(pbProject *api.Project) *types.Project {
 		EventWebhookURL:           pbProject.EventWebhookUrl,
 		EventWebhookEvents:        pbProject.EventWebhookEvents,
 		ClientDeactivateThreshold: pbProject.ClientDeactivateThreshold,
+		DocumentSchema:            pbProject.DocumentSchema,
 		PublicKey:                 pbProject.PublicKey,
 		SecretKey:                 pbProject.SecretKey,
 		CreatedAt:                 pbProject.CreatedAt.AsTime(),
@@ -86,6 +87,65 @@ func FromDocumentSummary(pbSummary *api.DocumentSummary) *types.DocumentSummary
 	}
 }
 
+// FromDocumentDiffs converts the given Protobuf formats to model format.
+func FromDocumentDiffs(pbDiffs []*api.DocumentDiff) []*crdt.Difference {
+	var diffs []*crdt.Difference
+	for _, pbDiff := range pbDiffs {
+		diffs = append(diffs, &crdt.Difference{
+			Type:      crdt.DiffType(pbDiff.Type),
+			Path:      pbDiff.Path,
+			From:      pbDiff.From,
+			To:        pbDiff.To,
+			FromIndex: int(pbDiff.FromIndex),
+			ToIndex:   int(pbDiff.ToIndex),
+			Index:     int(pbDiff.Index),
+			Content:   pbDiff.Content,
+			Author:    pbDiff.Author,
+		})
+	}
+	return diffs
+}
+
+// FromRevisionTags converts the given Protobuf formats to model format.
+func FromRevisionTags(pbTags []*api.RevisionTag) []*types.RevisionTag {
+	var tags []*types.RevisionTag
+	for _, pbTag := range pbTags {
+		tags = append(tags, FromRevisionTag(pbTag))
+	}
+	return tags
+}
+
+// FromRevisionTag converts the given Protobuf formats to model format.
+func FromRevisionTag(pbTag *api.RevisionTag) *types.RevisionTag {
+	return &types.RevisionTag{
+		Name:      pbTag.Name,
+		ServerSeq: pbTag.ServerSeq,
+		Message:   pbTag.Message,
+		Author:    pbTag.Author,
+		CreatedAt: pbTag.CreatedAt.AsTime(),
+	}
+}
+
+// FromDocumentAttributions converts the given Protobuf formats to model format.
+func FromDocumentAttributions(pbAttrs []*api.DocumentAttribution) []*crdt.Attribution {
+	var attrs []*crdt.Attribution
+	for _, pbAttr := range pbAttrs {
+		attr := &crdt.Attribution{
+			Path:      pbAttr.Path,
+			Index:     int(pbAttr.Index),
+			Content:   pbAttr.Content,
+			Author:    pbAttr.Author,
+			Lamport:   pbAttr.Lamport,
+			ServerSeq: pbAttr.ServerSeq,
+		}
+		if pbAttr.UpdatedAt != nil {
+			attr.UpdatedAt = pbAttr.UpdatedAt.AsTime()
+		}
+		attrs = append(attrs, attr)
+	}
+	return attrs
+}
+
 // FromChangePack converts the given Protobuf formats to model format.
 func FromChangePack(pbPack *api.ChangePack) (*change.Pack, error) {
 	if pbPack == nil {
@@ -134,7 +194,7 @@ func fromCheckpoint(pbCheckpoint *api.Checkpoint) change.Checkpoint {
 func FromChanges(pbChanges []*api.Change) ([]*change.Change, error) {
 	var changes []*change.Change
 	for _, pbChange := range pbChanges {
-		changeID, err := fromChangeID(pbChange.Id)
+		changeID, err := FromChangeID(pbChange.Id)
 		if err != nil {
 			return nil, err
 		}
@@ -153,7 +213,8 @@ func FromChanges(pbChanges []*api.Change) ([]*change.Change, error) {
 	return changes, nil
 }
 
-func fromChangeID(id *api.ChangeID) (change.ID, error) {
+// FromChangeID converts the given Protobuf formats to model format.
+func FromChangeID(id *api.ChangeID) (change.ID, error) {
 	actorID, err := time.ActorIDFromBytes(id.ActorId)
 	if err != nil {
 		return change.InitialID(), err
@@ -247,6 +308,8 @@ func FromOperations(pbOps []*api.Operation) ([]operations.Operation, error) {
 			op, err = fromTreeStyle(decoded.TreeStyle)
 		case *api.Operation_ArraySet_:
 			op, err = fromArraySet(decoded.ArraySet)
+		case *api.Operation_TreeMove_:
+			op, err = fromTreeMove(decoded.TreeMove)
 		default:
 			return nil, ErrUnsupportedOperation
 		}
@@ -455,6 +518,18 @@ func fromStyle(pbStyle *api.Operation_Style) (*operations.Style, error) {
 	if err != nil {
 		return nil, err
 	}
+
+	if len(pbStyle.AttributesToRemove) > 0 {
+		return operations.NewStyleRemove(
+			parentCreatedAt,
+			from,
+			to,
+			createdAtMapByActor,
+			pbStyle.AttributesToRemove,
+			executedAt,
+		), nil
+	}
+
 	return operations.NewStyle(
 		parentCreatedAt,
 		from,
@@ -578,6 +653,35 @@ func fromTreeStyle(pbTreeStyle *api.Operation_TreeStyle) (*operations.TreeStyle,
 	), nil
 }
 
+func fromTreeMove(pbTreeMove *api.Operation_TreeMove) (*operations.TreeMove, error) {
+	parentCreatedAt, err := fromTimeTicket(pbTreeMove.ParentCreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	executedAt, err := fromTimeTicket(pbTreeMove.ExecutedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	nodeID, err := fromTreeNodeID(pbTreeMove.NodeId)
+	if err != nil {
+		return nil, err
+	}
+
+	target, err := fromTreePos(pbTreeMove.Target)
+	if err != nil {
+		return nil, err
+	}
+
+	return operations.NewTreeMove(
+		parentCreatedAt,
+		nodeID,
+		target,
+		executedAt,
+	), nil
+}
+
 func fromArraySet(pbSetByIndex *api.Operation_ArraySet) (*operations.ArraySet, error) {
 	parentCreatedAt, err := fromTimeTicket(pbSetByIndex.ParentCreatedAt)
 	if err != nil {
@@ -730,6 +834,19 @@ func fromTreeNode(pbNode *api.TreeNode) (*crdt.TreeNode, error) {
 		}
 	}
 
+	if pbNode.GetMovedNodeId() != nil {
+		node.MovedNodeID, err = fromTreeNodeID(pbNode.GetMovedNodeId())
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	movedAt, err := fromTimeTicket(pbNode.MovedAt)
+	if err != nil {
+		return nil, err
+	}
+	node.SetMovedAt(movedAt)
+
 	removedAt, err := fromTimeTicket(pbNode.RemovedAt)
 	if err != nil {
 		return nil, err
@@ -933,6 +1050,9 @@ func FromUpdatableProjectFields(pbProjectFields *api.UpdatableProjectFields) (*t
 	if pbProjectFields.ClientDeactivateThreshold != nil {
 		updatableProjectFields.ClientDeactivateThreshold = &pbProjectFields.ClientDeactivateThreshold.Value
 	}
+	if pbProjectFields.DocumentSchema != nil {
+		updatableProjectFields.DocumentSchema = &pbProjectFields.DocumentSchema.Value
+	}
 
 	return updatableProjectFields, nil
 }
@@ -238,6 +238,7 @@ func toTextNodes(textNodes []*crdt.RGATreeSplitNode[*crdt.TextValue]) []*api.Tex
 			attrs[node.Key()] = &api.NodeAttr{
 				Value:     node.Value(),
 				UpdatedAt: ToTimeTicket(node.UpdatedAt()),
+				IsRemoved: node.IsRemoved(),
 			}
 		}
 
@@ -319,6 +320,7 @@ func toTreeNode(treeNode *crdt.TreeNode, depth int) *api.TreeNode {
 		Type:       treeNode.Type(),
 		Value:      treeNode.Value,
 		RemovedAt:  ToTimeTicket(treeNode.RemovedAt()),
+		MovedAt:    ToTimeTicket(treeNode.MovedAt()),
 		Depth:      int32(depth),
 		Attributes: toRHT(treeNode.Attrs),
 	}
@@ -331,6 +333,10 @@ func toTreeNode(treeNode *crdt.TreeNode, depth int) *api.TreeNode {
 		pbNode.InsNextId = toTreeNodeID(treeNode.InsNextID)
 	}
 
+	if treeNode.MovedNodeID != nil {
+		pbNode.MovedNodeId = toTreeNodeID(treeNode.MovedNodeID)
+	}
+
 	return pbNode
 }
 
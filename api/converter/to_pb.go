@@ -62,6 +62,7 @@ func ToProject(project *types.Project) *api.Project {
 		EventWebhookUrl:           project.EventWebhookURL,
 		EventWebhookEvents:        project.EventWebhookEvents,
 		ClientDeactivateThreshold: project.ClientDeactivateThreshold,
+		DocumentSchema:            project.DocumentSchema,
 		PublicKey:                 project.PublicKey,
 		SecretKey:                 project.SecretKey,
 		CreatedAt:                 timestamppb.New(project.CreatedAt),
@@ -90,6 +91,65 @@ func ToDocumentSummary(summary *types.DocumentSummary) *api.DocumentSummary {
 	}
 }
 
+// ToDocumentDiffs converts the given model to Protobuf format.
+func ToDocumentDiffs(diffs []*crdt.Difference) []*api.DocumentDiff {
+	var pbDiffs []*api.DocumentDiff
+	for _, diff := range diffs {
+		pbDiffs = append(pbDiffs, &api.DocumentDiff{
+			Type:      string(diff.Type),
+			Path:      diff.Path,
+			From:      diff.From,
+			To:        diff.To,
+			FromIndex: int32(diff.FromIndex),
+			ToIndex:   int32(diff.ToIndex),
+			Index:     int32(diff.Index),
+			Content:   diff.Content,
+			Author:    diff.Author,
+		})
+	}
+	return pbDiffs
+}
+
+// ToRevisionTags converts the given model to Protobuf format.
+func ToRevisionTags(tags []*types.RevisionTag) []*api.RevisionTag {
+	var pbTags []*api.RevisionTag
+	for _, tag := range tags {
+		pbTags = append(pbTags, ToRevisionTag(tag))
+	}
+	return pbTags
+}
+
+// ToRevisionTag converts the given model to Protobuf format.
+func ToRevisionTag(tag *types.RevisionTag) *api.RevisionTag {
+	return &api.RevisionTag{
+		Name:      tag.Name,
+		ServerSeq: tag.ServerSeq,
+		Message:   tag.Message,
+		Author:    tag.Author,
+		CreatedAt: timestamppb.New(tag.CreatedAt),
+	}
+}
+
+// ToDocumentAttributions converts the given model to Protobuf format.
+func ToDocumentAttributions(attrs []*crdt.Attribution) []*api.DocumentAttribution {
+	var pbAttrs []*api.DocumentAttribution
+	for _, attr := range attrs {
+		pbAttr := &api.DocumentAttribution{
+			Path:      attr.Path,
+			Index:     int32(attr.Index),
+			Content:   attr.Content,
+			Author:    attr.Author,
+			Lamport:   attr.Lamport,
+			ServerSeq: attr.ServerSeq,
+		}
+		if !attr.UpdatedAt.IsZero() {
+			pbAttr.UpdatedAt = timestamppb.New(attr.UpdatedAt)
+		}
+		pbAttrs = append(pbAttrs, pbAttr)
+	}
+	return pbAttrs
+}
+
 // ToPresences converts the given model to Protobuf format.
 func ToPresences(presences map[string]innerpresence.Presence) map[string]*api.Presence {
 	pbPresences := make(map[string]*api.Presence)
@@ -239,6 +299,8 @@ func ToOperations(ops []operations.Operation) ([]*api.Operation, error) {
 			pbOperation.Body, err = toTreeStyle(op)
 		case *operations.ArraySet:
 			pbOperation.Body, err = toArraySet(op)
+		case *operations.TreeMove:
+			pbOperation.Body, err = toTreeMove(op)
 		default:
 			return nil, ErrUnsupportedOperation
 		}
@@ -365,6 +427,7 @@ func toStyle(style *operations.Style) (*api.Operation_Style_, error) {
 			To:                  toTextNodePos(style.To()),
 			CreatedAtMapByActor: toCreatedAtMapByActor(style.MaxCreatedAtMapByActor()),
 			Attributes:          style.Attributes(),
+			AttributesToRemove:  style.AttributesToRemove(),
 			ExecutedAt:          ToTimeTicket(style.ExecutedAt()),
 		},
 	}, nil
@@ -429,6 +492,17 @@ func toArraySet(setByIndex *operations.ArraySet) (*api.Operation_ArraySet_, erro
 	}, nil
 }
 
+func toTreeMove(m *operations.TreeMove) (*api.Operation_TreeMove_, error) {
+	return &api.Operation_TreeMove_{
+		TreeMove: &api.Operation_TreeMove{
+			ParentCreatedAt: ToTimeTicket(m.ParentCreatedAt()),
+			NodeId:          toTreeNodeID(m.NodeID()),
+			Target:          toTreePos(m.TargetPos()),
+			ExecutedAt:      ToTimeTicket(m.ExecutedAt()),
+		},
+	}, nil
+}
+
 func toJSONElementSimple(elem crdt.Element) (*api.JSONElementSimple, error) {
 	switch elem := elem.(type) {
 	case *crdt.Object:
@@ -579,5 +653,8 @@ func ToUpdatableProjectFields(fields *types.UpdatableProjectFields) (*api.Updata
 			Value: *fields.ClientDeactivateThreshold,
 		}
 	}
+	if fields.DocumentSchema != nil {
+		pbUpdatableProjectFields.DocumentSchema = &wrapperspb.StringValue{Value: *fields.DocumentSchema}
+	}
 	return pbUpdatableProjectFields, nil
 }
@@ -22,6 +22,7 @@ import (
 	"fmt"
 	"io"
 
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 )
 
@@ -88,6 +89,63 @@ func AuthMethods() []Method {
 type AccessAttribute struct {
 	Key  string   `json:"key"`
 	Verb VerbType `json:"verb"`
+
+	// Rules is the path-scoped rules of the document. It is given by the
+	// response of the webhook to restrict writing to the specific paths.
+	Rules []PathRule `json:"rules,omitempty"`
+}
+
+// PathRule represents a rule of the access to the element of the path and its
+// descendants, e.g. "$.settings". The element can be written only if the verb
+// is ReadWrite.
+type PathRule struct {
+	Path string   `json:"path"`
+	Verb VerbType `json:"verb"`
+}
+
+// IsWritable returns whether the element of the given sub paths, e.g.
+// ["$", "settings"], can be written under the given rules. The most specific
+// rule that covers the element decides it, and the element without rules can
+// be written. The ancestors of the element of the read rule cannot be written
+// either, because writing them overwrites the element of the rule. The rule
+// whose path cannot be parsed is regarded as covering every element.
+func IsWritable(rules []PathRule, subPaths []string) bool {
+	var matched []string
+	matchedVerb := ReadWrite
+	for _, rule := range rules {
+		rulePaths, err := crdt.SplitPath(rule.Path)
+		if err != nil {
+			if rule.Verb != ReadWrite {
+				return false
+			}
+			continue
+		}
+
+		if isSubPathOf(subPaths, rulePaths) {
+			if matched == nil || len(rulePaths) > len(matched) {
+				matched = rulePaths
+				matchedVerb = rule.Verb
+			}
+		} else if isSubPathOf(rulePaths, subPaths) && rule.Verb != ReadWrite {
+			return false
+		}
+	}
+
+	return matchedVerb == ReadWrite
+}
+
+// isSubPathOf returns whether the given sub paths are the given parent sub
+// paths or their descendant.
+func isSubPathOf(subPaths, parent []string) bool {
+	if len(subPaths) < len(parent) {
+		return false
+	}
+	for i, p := range parent {
+		if subPaths[i] != p {
+			return false
+		}
+	}
+	return true
 }
 
 // NewAccessAttributes creates a new instance of AccessAttributes.
@@ -108,6 +166,28 @@ type AccessInfo struct {
 	Attributes []AccessAttribute
 }
 
+// SetRules sets the path-scoped rules of the given attributes to the
+// attributes of the same keys.
+func (i *AccessInfo) SetRules(attrs []AccessAttribute) {
+	for _, attr := range attrs {
+		for idx := range i.Attributes {
+			if i.Attributes[idx].Key == attr.Key {
+				i.Attributes[idx].Rules = attr.Rules
+			}
+		}
+	}
+}
+
+// RulesOf returns the path-scoped rules of the document of the given key.
+func (i *AccessInfo) RulesOf(docKey key.Key) []PathRule {
+	for _, attr := range i.Attributes {
+		if attr.Key == docKey.String() {
+			return attr.Rules
+		}
+	}
+	return nil
+}
+
 // AuthWebhookRequest represents the request of authentication webhook.
 type AuthWebhookRequest struct {
 	Token      string            `json:"token"`
@@ -130,6 +210,10 @@ func NewAuthWebhookRequest(reader io.Reader) (*AuthWebhookRequest, error) {
 type AuthWebhookResponse struct {
 	Allowed bool   `json:"allowed"`
 	Reason  string `json:"reason"`
+
+	// Attributes is the access attributes with the path-scoped rules of the
+	// documents. It is only used when the access is allowed.
+	Attributes []AccessAttribute `json:"attributes,omitempty"`
 }
 
 // NewAuthWebhookResponse creates a new instance of AuthWebhookResponse.
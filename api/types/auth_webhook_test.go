@@ -0,0 +1,76 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+func TestAuthWebhook(t *testing.T) {
+	t.Run("is writable test", func(t *testing.T) {
+		rules := []types.PathRule{
+			{Path: "$.settings", Verb: types.Read},
+			{Path: "$.settings.theme", Verb: types.ReadWrite},
+		}
+
+		isWritable := func(rules []types.PathRule, path string) bool {
+			subPaths, err := crdt.SplitPath(path)
+			assert.NoError(t, err)
+			return types.IsWritable(rules, subPaths)
+		}
+
+		assert.True(t, isWritable(nil, "$.settings"))
+		assert.True(t, isWritable(rules, "$.todos"))
+		assert.True(t, isWritable(rules, "$.settingsV2"))
+		assert.False(t, isWritable(rules, "$.settings"))
+		assert.False(t, isWritable(rules, "$.settings.lang"))
+		assert.True(t, isWritable(rules, "$.settings.theme"))
+		assert.True(t, isWritable(rules, "$.settings.theme.color"))
+
+		// the ancestors of the read rule cannot be written
+		assert.False(t, isWritable(rules, "$"))
+		assert.True(t, isWritable([]types.PathRule{{Path: "$.a.b", Verb: types.ReadWrite}}, "$.a"))
+
+		// the paths are compared by the keys and the indexes
+		assert.False(t, isWritable([]types.PathRule{{Path: "$.a", Verb: types.Read}}, "$.a[0]"))
+		assert.True(t, isWritable([]types.PathRule{{Path: "$.a", Verb: types.Read}}, `$["a.b"]`))
+		assert.False(t, isWritable([]types.PathRule{{Path: `$["a.b"]`, Verb: types.Read}}, `$["a.b"].c`))
+		assert.True(t, isWritable([]types.PathRule{{Path: `$["a.b"]`, Verb: types.Read}}, "$.a.b"))
+
+		// the rule with the invalid path covers every element
+		assert.False(t, isWritable([]types.PathRule{{Path: "$.a[", Verb: types.Read}}, "$.b"))
+	})
+
+	t.Run("set rules test", func(t *testing.T) {
+		accessInfo := &types.AccessInfo{
+			Method:     types.PushPull,
+			Attributes: types.NewAccessAttributes([]key.Key{"d1", "d2"}, types.ReadWrite),
+		}
+		rules := []types.PathRule{{Path: "$.settings", Verb: types.Read}}
+		accessInfo.SetRules([]types.AccessAttribute{{Key: "d2", Verb: types.ReadWrite, Rules: rules}})
+
+		assert.Nil(t, accessInfo.RulesOf("d1"))
+		assert.Equal(t, rules, accessInfo.RulesOf("d2"))
+		assert.Nil(t, accessInfo.RulesOf("d3"))
+	})
+}
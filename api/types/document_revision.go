@@ -0,0 +1,37 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// DocumentRevision represents the contents of a document at a revision.
+type DocumentRevision struct {
+	// Key is the key of the document.
+	Key key.Key `json:"key"`
+
+	// ServerSeq is the server sequence of the last change in the revision.
+	ServerSeq int64 `json:"server_seq"`
+
+	// Root is the JSON representation of the root of the document.
+	Root string `json:"root"`
+
+	// Presences is the presences of the clients in the revision.
+	Presences map[string]innerpresence.Presence `json:"presences"`
+}
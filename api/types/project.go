@@ -48,6 +48,10 @@ type Project struct {
 	// specific project are considered deactivate for housekeeping.
 	ClientDeactivateThreshold string `bson:"client_deactivate_threshold"`
 
+	// DocumentSchema is the schema that the root of documents in this project
+	// should follow. If it is empty, the root is not validated.
+	DocumentSchema string `json:"document_schema"`
+
 	// PublicKey is the API key of this project.
 	PublicKey string `json:"public_key"`
 
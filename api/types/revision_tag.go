@@ -0,0 +1,39 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package types
+
+import (
+	"time"
+)
+
+// RevisionTag represents a tag that names a revision of a document.
+type RevisionTag struct {
+	// Name is the name of the tag.
+	Name string `json:"name"`
+
+	// ServerSeq is the server sequence of the revision which the tag points to.
+	ServerSeq int64 `json:"server_seq"`
+
+	// Message is the message of the tag.
+	Message string `json:"message"`
+
+	// Author is the name of the user who created the tag.
+	Author string `json:"author"`
+
+	// CreatedAt is the time when the tag is created.
+	CreatedAt time.Time `json:"created_at"`
+}
@@ -47,6 +47,11 @@ type UpdatableProjectFields struct {
 
 	// ClientDeactivateThreshold is the time after which clients in specific project are considered deactivate.
 	ClientDeactivateThreshold *string `bson:"client_deactivate_threshold,omitempty" validate:"omitempty,min=2,duration"`
+
+	// DocumentSchema is the schema that the root of documents should follow.
+	// Only its JSON syntax is validated here, and its rules are validated by
+	// the server with the schema package.
+	DocumentSchema *string `bson:"document_schema,omitempty" validate:"omitempty,json|emptystring"`
 }
 
 // Validate validates the UpdatableProjectFields.
@@ -56,7 +61,8 @@ func (i *UpdatableProjectFields) Validate() error {
 		i.AuthWebhookMethods == nil &&
 		i.ClientDeactivateThreshold == nil &&
 		i.EventWebhookURL == nil &&
-		i.EventWebhookEvents == nil {
+		i.EventWebhookEvents == nil &&
+		i.DocumentSchema == nil {
 		return ErrEmptyProjectFields
 	}
 
@@ -105,4 +111,9 @@ func init() {
 		fmt.Fprintln(os.Stderr, "updatable project fields: ", err)
 		os.Exit(1)
 	}
+
+	if err := validation.RegisterTranslation("json|emptystring", "given {0} is invalid document schema"); err != nil {
+		fmt.Fprintln(os.Stderr, "updatable project fields: ", err)
+		os.Exit(1)
+	}
 }
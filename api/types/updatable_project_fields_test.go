@@ -126,4 +126,24 @@ func TestUpdatableProjectFields(t *testing.T) {
 		}
 		assert.ErrorAs(t, fields.Validate(), &formErr)
 	})
+
+	t.Run("document schema test", func(t *testing.T) {
+		validSchema := `{"type":"object","properties":{"title":{"type":"string"}}}`
+		fields := &types.UpdatableProjectFields{
+			DocumentSchema: &validSchema,
+		}
+		assert.NoError(t, fields.Validate())
+
+		emptySchema := ""
+		fields = &types.UpdatableProjectFields{
+			DocumentSchema: &emptySchema,
+		}
+		assert.NoError(t, fields.Validate())
+
+		invalidSchema := `{"type":"object"`
+		fields = &types.UpdatableProjectFields{
+			DocumentSchema: &invalidSchema,
+		}
+		assert.ErrorAs(t, fields.Validate(), &formErr)
+	})
 }
@@ -84,6 +84,30 @@ const (
 	// AdminServiceGetSnapshotMetaProcedure is the fully-qualified name of the AdminService's
 	// GetSnapshotMeta RPC.
 	AdminServiceGetSnapshotMetaProcedure = "/yorkie.v1.AdminService/GetSnapshotMeta"
+	// AdminServiceGetDocumentAtRevisionProcedure is the fully-qualified name of the AdminService's
+	// GetDocumentAtRevision RPC.
+	AdminServiceGetDocumentAtRevisionProcedure = "/yorkie.v1.AdminService/GetDocumentAtRevision"
+	// AdminServiceDiffDocumentProcedure is the fully-qualified name of the AdminService's DiffDocument
+	// RPC.
+	AdminServiceDiffDocumentProcedure = "/yorkie.v1.AdminService/DiffDocument"
+	// AdminServiceBlameDocumentProcedure is the fully-qualified name of the AdminService's
+	// BlameDocument RPC.
+	AdminServiceBlameDocumentProcedure = "/yorkie.v1.AdminService/BlameDocument"
+	// AdminServiceCreateRevisionTagProcedure is the fully-qualified name of the AdminService's
+	// CreateRevisionTag RPC.
+	AdminServiceCreateRevisionTagProcedure = "/yorkie.v1.AdminService/CreateRevisionTag"
+	// AdminServiceListRevisionTagsProcedure is the fully-qualified name of the AdminService's
+	// ListRevisionTags RPC.
+	AdminServiceListRevisionTagsProcedure = "/yorkie.v1.AdminService/ListRevisionTags"
+	// AdminServiceDeleteRevisionTagProcedure is the fully-qualified name of the AdminService's
+	// DeleteRevisionTag RPC.
+	AdminServiceDeleteRevisionTagProcedure = "/yorkie.v1.AdminService/DeleteRevisionTag"
+	// AdminServiceForkDocumentProcedure is the fully-qualified name of the AdminService's ForkDocument
+	// RPC.
+	AdminServiceForkDocumentProcedure = "/yorkie.v1.AdminService/ForkDocument"
+	// AdminServiceRestoreDocumentProcedure is the fully-qualified name of the AdminService's
+	// RestoreDocument RPC.
+	AdminServiceRestoreDocumentProcedure = "/yorkie.v1.AdminService/RestoreDocument"
 	// AdminServiceSearchDocumentsProcedure is the fully-qualified name of the AdminService's
 	// SearchDocuments RPC.
 	AdminServiceSearchDocumentsProcedure = "/yorkie.v1.AdminService/SearchDocuments"
@@ -110,6 +134,14 @@ type AdminServiceClient interface {
 	GetDocuments(context.Context, *connect.Request[v1.GetDocumentsRequest]) (*connect.Response[v1.GetDocumentsResponse], error)
 	RemoveDocumentByAdmin(context.Context, *connect.Request[v1.RemoveDocumentByAdminRequest]) (*connect.Response[v1.RemoveDocumentByAdminResponse], error)
 	GetSnapshotMeta(context.Context, *connect.Request[v1.GetSnapshotMetaRequest]) (*connect.Response[v1.GetSnapshotMetaResponse], error)
+	GetDocumentAtRevision(context.Context, *connect.Request[v1.GetDocumentAtRevisionRequest]) (*connect.Response[v1.GetDocumentAtRevisionResponse], error)
+	DiffDocument(context.Context, *connect.Request[v1.DiffDocumentRequest]) (*connect.Response[v1.DiffDocumentResponse], error)
+	BlameDocument(context.Context, *connect.Request[v1.BlameDocumentRequest]) (*connect.Response[v1.BlameDocumentResponse], error)
+	CreateRevisionTag(context.Context, *connect.Request[v1.CreateRevisionTagRequest]) (*connect.Response[v1.CreateRevisionTagResponse], error)
+	ListRevisionTags(context.Context, *connect.Request[v1.ListRevisionTagsRequest]) (*connect.Response[v1.ListRevisionTagsResponse], error)
+	DeleteRevisionTag(context.Context, *connect.Request[v1.DeleteRevisionTagRequest]) (*connect.Response[v1.DeleteRevisionTagResponse], error)
+	ForkDocument(context.Context, *connect.Request[v1.ForkDocumentRequest]) (*connect.Response[v1.ForkDocumentResponse], error)
+	RestoreDocument(context.Context, *connect.Request[v1.RestoreDocumentRequest]) (*connect.Response[v1.RestoreDocumentResponse], error)
 	SearchDocuments(context.Context, *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error)
 	ListChanges(context.Context, *connect.Request[v1.ListChangesRequest]) (*connect.Response[v1.ListChangesResponse], error)
 	GetServerVersion(context.Context, *connect.Request[v1.GetServerVersionRequest]) (*connect.Response[v1.GetServerVersionResponse], error)
@@ -190,6 +222,46 @@ func NewAdminServiceClient(httpClient connect.HTTPClient, baseURL string, opts .
 			baseURL+AdminServiceGetSnapshotMetaProcedure,
 			opts...,
 		),
+		getDocumentAtRevision: connect.NewClient[v1.GetDocumentAtRevisionRequest, v1.GetDocumentAtRevisionResponse](
+			httpClient,
+			baseURL+AdminServiceGetDocumentAtRevisionProcedure,
+			opts...,
+		),
+		diffDocument: connect.NewClient[v1.DiffDocumentRequest, v1.DiffDocumentResponse](
+			httpClient,
+			baseURL+AdminServiceDiffDocumentProcedure,
+			opts...,
+		),
+		blameDocument: connect.NewClient[v1.BlameDocumentRequest, v1.BlameDocumentResponse](
+			httpClient,
+			baseURL+AdminServiceBlameDocumentProcedure,
+			opts...,
+		),
+		createRevisionTag: connect.NewClient[v1.CreateRevisionTagRequest, v1.CreateRevisionTagResponse](
+			httpClient,
+			baseURL+AdminServiceCreateRevisionTagProcedure,
+			opts...,
+		),
+		listRevisionTags: connect.NewClient[v1.ListRevisionTagsRequest, v1.ListRevisionTagsResponse](
+			httpClient,
+			baseURL+AdminServiceListRevisionTagsProcedure,
+			opts...,
+		),
+		deleteRevisionTag: connect.NewClient[v1.DeleteRevisionTagRequest, v1.DeleteRevisionTagResponse](
+			httpClient,
+			baseURL+AdminServiceDeleteRevisionTagProcedure,
+			opts...,
+		),
+		forkDocument: connect.NewClient[v1.ForkDocumentRequest, v1.ForkDocumentResponse](
+			httpClient,
+			baseURL+AdminServiceForkDocumentProcedure,
+			opts...,
+		),
+		restoreDocument: connect.NewClient[v1.RestoreDocumentRequest, v1.RestoreDocumentResponse](
+			httpClient,
+			baseURL+AdminServiceRestoreDocumentProcedure,
+			opts...,
+		),
 		searchDocuments: connect.NewClient[v1.SearchDocumentsRequest, v1.SearchDocumentsResponse](
 			httpClient,
 			baseURL+AdminServiceSearchDocumentsProcedure,
@@ -223,6 +295,14 @@ type adminServiceClient struct {
 	getDocuments          *connect.Client[v1.GetDocumentsRequest, v1.GetDocumentsResponse]
 	removeDocumentByAdmin *connect.Client[v1.RemoveDocumentByAdminRequest, v1.RemoveDocumentByAdminResponse]
 	getSnapshotMeta       *connect.Client[v1.GetSnapshotMetaRequest, v1.GetSnapshotMetaResponse]
+	getDocumentAtRevision *connect.Client[v1.GetDocumentAtRevisionRequest, v1.GetDocumentAtRevisionResponse]
+	diffDocument          *connect.Client[v1.DiffDocumentRequest, v1.DiffDocumentResponse]
+	blameDocument         *connect.Client[v1.BlameDocumentRequest, v1.BlameDocumentResponse]
+	createRevisionTag     *connect.Client[v1.CreateRevisionTagRequest, v1.CreateRevisionTagResponse]
+	listRevisionTags      *connect.Client[v1.ListRevisionTagsRequest, v1.ListRevisionTagsResponse]
+	deleteRevisionTag     *connect.Client[v1.DeleteRevisionTagRequest, v1.DeleteRevisionTagResponse]
+	forkDocument          *connect.Client[v1.ForkDocumentRequest, v1.ForkDocumentResponse]
+	restoreDocument       *connect.Client[v1.RestoreDocumentRequest, v1.RestoreDocumentResponse]
 	searchDocuments       *connect.Client[v1.SearchDocumentsRequest, v1.SearchDocumentsResponse]
 	listChanges           *connect.Client[v1.ListChangesRequest, v1.ListChangesResponse]
 	getServerVersion      *connect.Client[v1.GetServerVersionRequest, v1.GetServerVersionResponse]
@@ -293,6 +373,46 @@ func (c *adminServiceClient) GetSnapshotMeta(ctx context.Context, req *connect.R
 	return c.getSnapshotMeta.CallUnary(ctx, req)
 }
 
+// GetDocumentAtRevision calls yorkie.v1.AdminService.GetDocumentAtRevision.
+func (c *adminServiceClient) GetDocumentAtRevision(ctx context.Context, req *connect.Request[v1.GetDocumentAtRevisionRequest]) (*connect.Response[v1.GetDocumentAtRevisionResponse], error) {
+	return c.getDocumentAtRevision.CallUnary(ctx, req)
+}
+
+// DiffDocument calls yorkie.v1.AdminService.DiffDocument.
+func (c *adminServiceClient) DiffDocument(ctx context.Context, req *connect.Request[v1.DiffDocumentRequest]) (*connect.Response[v1.DiffDocumentResponse], error) {
+	return c.diffDocument.CallUnary(ctx, req)
+}
+
+// BlameDocument calls yorkie.v1.AdminService.BlameDocument.
+func (c *adminServiceClient) BlameDocument(ctx context.Context, req *connect.Request[v1.BlameDocumentRequest]) (*connect.Response[v1.BlameDocumentResponse], error) {
+	return c.blameDocument.CallUnary(ctx, req)
+}
+
+// CreateRevisionTag calls yorkie.v1.AdminService.CreateRevisionTag.
+func (c *adminServiceClient) CreateRevisionTag(ctx context.Context, req *connect.Request[v1.CreateRevisionTagRequest]) (*connect.Response[v1.CreateRevisionTagResponse], error) {
+	return c.createRevisionTag.CallUnary(ctx, req)
+}
+
+// ListRevisionTags calls yorkie.v1.AdminService.ListRevisionTags.
+func (c *adminServiceClient) ListRevisionTags(ctx context.Context, req *connect.Request[v1.ListRevisionTagsRequest]) (*connect.Response[v1.ListRevisionTagsResponse], error) {
+	return c.listRevisionTags.CallUnary(ctx, req)
+}
+
+// DeleteRevisionTag calls yorkie.v1.AdminService.DeleteRevisionTag.
+func (c *adminServiceClient) DeleteRevisionTag(ctx context.Context, req *connect.Request[v1.DeleteRevisionTagRequest]) (*connect.Response[v1.DeleteRevisionTagResponse], error) {
+	return c.deleteRevisionTag.CallUnary(ctx, req)
+}
+
+// ForkDocument calls yorkie.v1.AdminService.ForkDocument.
+func (c *adminServiceClient) ForkDocument(ctx context.Context, req *connect.Request[v1.ForkDocumentRequest]) (*connect.Response[v1.ForkDocumentResponse], error) {
+	return c.forkDocument.CallUnary(ctx, req)
+}
+
+// RestoreDocument calls yorkie.v1.AdminService.RestoreDocument.
+func (c *adminServiceClient) RestoreDocument(ctx context.Context, req *connect.Request[v1.RestoreDocumentRequest]) (*connect.Response[v1.RestoreDocumentResponse], error) {
+	return c.restoreDocument.CallUnary(ctx, req)
+}
+
 // SearchDocuments calls yorkie.v1.AdminService.SearchDocuments.
 func (c *adminServiceClient) SearchDocuments(ctx context.Context, req *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error) {
 	return c.searchDocuments.CallUnary(ctx, req)
@@ -323,6 +443,14 @@ type AdminServiceHandler interface {
 	GetDocuments(context.Context, *connect.Request[v1.GetDocumentsRequest]) (*connect.Response[v1.GetDocumentsResponse], error)
 	RemoveDocumentByAdmin(context.Context, *connect.Request[v1.RemoveDocumentByAdminRequest]) (*connect.Response[v1.RemoveDocumentByAdminResponse], error)
 	GetSnapshotMeta(context.Context, *connect.Request[v1.GetSnapshotMetaRequest]) (*connect.Response[v1.GetSnapshotMetaResponse], error)
+	GetDocumentAtRevision(context.Context, *connect.Request[v1.GetDocumentAtRevisionRequest]) (*connect.Response[v1.GetDocumentAtRevisionResponse], error)
+	DiffDocument(context.Context, *connect.Request[v1.DiffDocumentRequest]) (*connect.Response[v1.DiffDocumentResponse], error)
+	BlameDocument(context.Context, *connect.Request[v1.BlameDocumentRequest]) (*connect.Response[v1.BlameDocumentResponse], error)
+	CreateRevisionTag(context.Context, *connect.Request[v1.CreateRevisionTagRequest]) (*connect.Response[v1.CreateRevisionTagResponse], error)
+	ListRevisionTags(context.Context, *connect.Request[v1.ListRevisionTagsRequest]) (*connect.Response[v1.ListRevisionTagsResponse], error)
+	DeleteRevisionTag(context.Context, *connect.Request[v1.DeleteRevisionTagRequest]) (*connect.Response[v1.DeleteRevisionTagResponse], error)
+	ForkDocument(context.Context, *connect.Request[v1.ForkDocumentRequest]) (*connect.Response[v1.ForkDocumentResponse], error)
+	RestoreDocument(context.Context, *connect.Request[v1.RestoreDocumentRequest]) (*connect.Response[v1.RestoreDocumentResponse], error)
 	SearchDocuments(context.Context, *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error)
 	ListChanges(context.Context, *connect.Request[v1.ListChangesRequest]) (*connect.Response[v1.ListChangesResponse], error)
 	GetServerVersion(context.Context, *connect.Request[v1.GetServerVersionRequest]) (*connect.Response[v1.GetServerVersionResponse], error)
@@ -399,6 +527,46 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 		svc.GetSnapshotMeta,
 		opts...,
 	)
+	adminServiceGetDocumentAtRevisionHandler := connect.NewUnaryHandler(
+		AdminServiceGetDocumentAtRevisionProcedure,
+		svc.GetDocumentAtRevision,
+		opts...,
+	)
+	adminServiceDiffDocumentHandler := connect.NewUnaryHandler(
+		AdminServiceDiffDocumentProcedure,
+		svc.DiffDocument,
+		opts...,
+	)
+	adminServiceBlameDocumentHandler := connect.NewUnaryHandler(
+		AdminServiceBlameDocumentProcedure,
+		svc.BlameDocument,
+		opts...,
+	)
+	adminServiceCreateRevisionTagHandler := connect.NewUnaryHandler(
+		AdminServiceCreateRevisionTagProcedure,
+		svc.CreateRevisionTag,
+		opts...,
+	)
+	adminServiceListRevisionTagsHandler := connect.NewUnaryHandler(
+		AdminServiceListRevisionTagsProcedure,
+		svc.ListRevisionTags,
+		opts...,
+	)
+	adminServiceDeleteRevisionTagHandler := connect.NewUnaryHandler(
+		AdminServiceDeleteRevisionTagProcedure,
+		svc.DeleteRevisionTag,
+		opts...,
+	)
+	adminServiceForkDocumentHandler := connect.NewUnaryHandler(
+		AdminServiceForkDocumentProcedure,
+		svc.ForkDocument,
+		opts...,
+	)
+	adminServiceRestoreDocumentHandler := connect.NewUnaryHandler(
+		AdminServiceRestoreDocumentProcedure,
+		svc.RestoreDocument,
+		opts...,
+	)
 	adminServiceSearchDocumentsHandler := connect.NewUnaryHandler(
 		AdminServiceSearchDocumentsProcedure,
 		svc.SearchDocuments,
@@ -442,6 +610,22 @@ func NewAdminServiceHandler(svc AdminServiceHandler, opts ...connect.HandlerOpti
 			adminServiceRemoveDocumentByAdminHandler.ServeHTTP(w, r)
 		case AdminServiceGetSnapshotMetaProcedure:
 			adminServiceGetSnapshotMetaHandler.ServeHTTP(w, r)
+		case AdminServiceGetDocumentAtRevisionProcedure:
+			adminServiceGetDocumentAtRevisionHandler.ServeHTTP(w, r)
+		case AdminServiceDiffDocumentProcedure:
+			adminServiceDiffDocumentHandler.ServeHTTP(w, r)
+		case AdminServiceBlameDocumentProcedure:
+			adminServiceBlameDocumentHandler.ServeHTTP(w, r)
+		case AdminServiceCreateRevisionTagProcedure:
+			adminServiceCreateRevisionTagHandler.ServeHTTP(w, r)
+		case AdminServiceListRevisionTagsProcedure:
+			adminServiceListRevisionTagsHandler.ServeHTTP(w, r)
+		case AdminServiceDeleteRevisionTagProcedure:
+			adminServiceDeleteRevisionTagHandler.ServeHTTP(w, r)
+		case AdminServiceForkDocumentProcedure:
+			adminServiceForkDocumentHandler.ServeHTTP(w, r)
+		case AdminServiceRestoreDocumentProcedure:
+			adminServiceRestoreDocumentHandler.ServeHTTP(w, r)
 		case AdminServiceSearchDocumentsProcedure:
 			adminServiceSearchDocumentsHandler.ServeHTTP(w, r)
 		case AdminServiceListChangesProcedure:
@@ -509,6 +693,38 @@ func (UnimplementedAdminServiceHandler) GetSnapshotMeta(context.Context, *connec
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.GetSnapshotMeta is not implemented"))
 }
 
+func (UnimplementedAdminServiceHandler) GetDocumentAtRevision(context.Context, *connect.Request[v1.GetDocumentAtRevisionRequest]) (*connect.Response[v1.GetDocumentAtRevisionResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.GetDocumentAtRevision is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DiffDocument(context.Context, *connect.Request[v1.DiffDocumentRequest]) (*connect.Response[v1.DiffDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.DiffDocument is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) BlameDocument(context.Context, *connect.Request[v1.BlameDocumentRequest]) (*connect.Response[v1.BlameDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.BlameDocument is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) CreateRevisionTag(context.Context, *connect.Request[v1.CreateRevisionTagRequest]) (*connect.Response[v1.CreateRevisionTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.CreateRevisionTag is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ListRevisionTags(context.Context, *connect.Request[v1.ListRevisionTagsRequest]) (*connect.Response[v1.ListRevisionTagsResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.ListRevisionTags is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) DeleteRevisionTag(context.Context, *connect.Request[v1.DeleteRevisionTagRequest]) (*connect.Response[v1.DeleteRevisionTagResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.DeleteRevisionTag is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) ForkDocument(context.Context, *connect.Request[v1.ForkDocumentRequest]) (*connect.Response[v1.ForkDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.ForkDocument is not implemented"))
+}
+
+func (UnimplementedAdminServiceHandler) RestoreDocument(context.Context, *connect.Request[v1.RestoreDocumentRequest]) (*connect.Response[v1.RestoreDocumentResponse], error) {
+	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.RestoreDocument is not implemented"))
+}
+
 func (UnimplementedAdminServiceHandler) SearchDocuments(context.Context, *connect.Request[v1.SearchDocumentsRequest]) (*connect.Response[v1.SearchDocumentsResponse], error) {
 	return nil, connect.NewError(connect.CodeUnimplemented, errors.New("yorkie.v1.AdminService.SearchDocuments is not implemented"))
 }
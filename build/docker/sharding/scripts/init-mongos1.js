@@ -24,6 +24,7 @@ sh.shardCollection(mongoClientDB + ".changes", { doc_id: 1 });
 sh.shardCollection(mongoClientDB + ".snapshots", { doc_id: 1 });
 sh.shardCollection(mongoClientDB + ".syncedseqs", { doc_id: 1 });
 sh.shardCollection(mongoClientDB + ".versionvectors", { doc_id: 1 });
+sh.shardCollection(mongoClientDB + ".revisiontags", { doc_id: 1 });
 
 // Split the inital range at `splitPoint` to allow doc_ids duplicate in different shards.
 const splitPoint = ObjectId("500000000000000000000000");
@@ -44,3 +45,4 @@ sh.shardCollection(serverDB + ".changes", { doc_id: 1 });
 sh.shardCollection(serverDB + ".snapshots", { doc_id: 1 });
 sh.shardCollection(serverDB + ".syncedseqs", { doc_id: 1 });
 sh.shardCollection(serverDB + ".versionvectors", { doc_id: 1 });
+sh.shardCollection(serverDB + ".revisiontags", { doc_id: 1 });
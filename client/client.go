@@ -28,7 +28,9 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"sync"
 	"sync/atomic"
+	gotime "time"
 
 	"connectrpc.com/connect"
 	"github.com/rs/xid"
@@ -40,6 +42,7 @@ import (
 	"github.com/yorkie-team/yorkie/api/types/events"
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/api/yorkie/v1/v1connect"
+	"github.com/yorkie-team/yorkie/pkg/cmap"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
@@ -48,6 +51,10 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// DefaultPersistDebounce is the default time to wait for more local changes
+// before persisting the document to the store.
+const DefaultPersistDebounce = 100 * gotime.Millisecond
+
 type status int
 
 const (
@@ -77,6 +84,10 @@ var (
 
 	// ErrAlreadySubscribed occurs when the client is already subscribed to the document.
 	ErrAlreadySubscribed = errors.New("already subscribed")
+
+	// ErrAutoSyncDisabled occurs when the background synchronization is
+	// requested for the document attached without WithAutoSync.
+	ErrAutoSyncDisabled = errors.New("auto sync is disabled")
 )
 
 // Attachment represents the document attached.
@@ -90,6 +101,30 @@ type Attachment struct {
 	rch              <-chan WatchResponse
 	watchCtx         context.Context
 	closeWatchStream context.CancelFunc
+
+	// unsubscribeChanges stops persisting the local changes to the store.
+	unsubscribeChanges func()
+
+	// persistMu serializes the persisting of the document, so that the older
+	// state does not overwrite the newer one. It also guards persistTimer and
+	// unpersisted.
+	persistMu sync.Mutex
+
+	// persistTimer persists the local changes made during the debounce. It is
+	// nil if no persisting is scheduled.
+	persistTimer *gotime.Timer
+
+	// unpersisted is set when the state is deleted from the store, so that
+	// the scheduled persisting does not store it again.
+	unpersisted bool
+
+	// syncMu serializes the synchronizations of the document, so that the
+	// changes pulled by concurrent synchronizations are not applied twice.
+	syncMu sync.Mutex
+
+	// syncManager synchronizes the document in background. It is set only if
+	// the document is attached with WithAutoSync.
+	syncManager *syncManager
 }
 
 // Client is a normal client that can communicate with the server.
@@ -106,7 +141,7 @@ type Client struct {
 	id          *time.ActorID
 	key         string
 	status      status
-	attachments map[key.Key]*Attachment
+	attachments *cmap.Map[key.Key, *Attachment]
 }
 
 // WatchResponseType is type of watch response.
@@ -119,6 +154,11 @@ const (
 	DocumentUnwatched WatchResponseType = "document-unwatched"
 	PresenceChanged   WatchResponseType = "presence-changed"
 	DocumentBroadcast WatchResponseType = "document-broadcast"
+
+	// LocalChanged and RemoteChanged are sent only for the document created
+	// with document.WithChangeEvents.
+	LocalChanged  WatchResponseType = "local-changed"
+	RemoteChanged WatchResponseType = "remote-changed"
 )
 
 // WatchResponse is a structure representing response of Watch.
@@ -126,6 +166,10 @@ type WatchResponse struct {
 	Type      WatchResponseType
 	Presences map[string]innerpresence.Presence
 	Err       error
+
+	// Change is the information of the change applied to the document. It is
+	// set only for LocalChanged and RemoteChanged.
+	Change *document.ChangeInfo
 }
 
 // New creates an instance of Client.
@@ -136,8 +180,20 @@ func New(opts ...Option) (*Client, error) {
 	}
 
 	k := options.Key
+	if k == "" && options.Store != nil {
+		storedKey, err := options.Store.LoadClientKey()
+		if err != nil {
+			return nil, fmt.Errorf("load client key: %w", err)
+		}
+		k = storedKey
+	}
 	if k == "" {
 		k = xid.New().String()
+		if options.Store != nil {
+			if err := options.Store.SaveClientKey(k); err != nil {
+				return nil, fmt.Errorf("save client key: %w", err)
+			}
+		}
 	}
 
 	conn := &http.Client{}
@@ -175,7 +231,7 @@ func New(opts ...Option) (*Client, error) {
 
 		key:         k,
 		status:      deactivated,
-		attachments: make(map[key.Key]*Attachment),
+		attachments: cmap.New[key.Key, *Attachment](),
 	}, nil
 }
 
@@ -259,6 +315,18 @@ func (c *Client) Deactivate(ctx context.Context) error {
 		return nil
 	}
 
+	for _, attachment := range c.attachments.Values() {
+		if attachment.syncManager != nil {
+			attachment.syncManager.stop()
+		}
+
+		// NOTE: The local changes waiting for the debounce are persisted
+		// here, so that they are not lost after the client is closed.
+		if err := c.persist(attachment); err != nil {
+			return err
+		}
+	}
+
 	_, err := c.client.DeactivateClient(
 		ctx,
 		withShardKey(connect.NewRequest(&api.DeactivateClientRequest{
@@ -276,6 +344,10 @@ func (c *Client) Deactivate(ctx context.Context) error {
 
 // Attach attaches the given document to this client. It tells the server that
 // this client will synchronize the given document.
+//
+// If the store of the client has the state of the document, the document is
+// restored from the state and the local changes made before restart are
+// pushed to the server.
 func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...AttachOption) error {
 	if c.status != activated {
 		return ErrClientNotActivated
@@ -290,6 +362,30 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		opt(opts)
 	}
 
+	if c.options.Store != nil {
+		state, err := c.options.Store.LoadDocument(doc.Key())
+		if err != nil {
+			return err
+		}
+		if state != nil && state.Local.ChangeID.ActorID().Compare(c.id) == 0 {
+			return c.resume(ctx, doc, state, opts)
+		}
+
+		// NOTE: The state stored by another actor, e.g. the client re-opened
+		// with another key, cannot be pushed by this client. It is discarded
+		// and the document is attached freshly.
+		if state != nil {
+			c.logger.Warn(
+				"discard the state stored by another actor",
+				zap.String("key", doc.Key().String()),
+				zap.String("actor", state.Local.ChangeID.ActorID().String()),
+			)
+			if err := c.options.Store.DeleteDocument(doc.Key()); err != nil {
+				return err
+			}
+		}
+	}
+
 	doc.SetActor(c.id)
 
 	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
@@ -299,11 +395,78 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		return err
 	}
 
-	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
+	docID, err := c.attachDocument(ctx, doc)
 	if err != nil {
 		return err
 	}
 
+	if doc.Status() == document.StatusRemoved {
+		return nil
+	}
+
+	return c.addAttachment(ctx, doc, docID, opts)
+}
+
+// resume restores the given document from the given state in the store and
+// pushes the local changes made before restart to the server.
+func (c *Client) resume(
+	ctx context.Context,
+	doc *document.Document,
+	state *DocumentState,
+	opts *AttachOptions,
+) error {
+	if err := doc.ApplyLocalState(state.Local); err != nil {
+		return err
+	}
+
+	// NOTE: The presence in the state may be stale, so it is initialized
+	// with the given presence as Attach does.
+	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+		p.Initialize(opts.Presence)
+		return nil
+	}); err != nil {
+		return err
+	}
+
+	doc.SetStatus(document.StatusAttached)
+	c.attachments.Set(doc.Key(), &Attachment{
+		doc:   doc,
+		docID: state.ID,
+	})
+
+	docID := state.ID
+	if err := c.pushPullChanges(ctx, WithDocKey(doc.Key())); err != nil {
+		c.removeAttachment(doc.Key())
+		doc.SetStatus(document.StatusDetached)
+
+		// NOTE: The server may no longer have the document attached to this
+		// client, e.g. the server has restarted with the memory database. In
+		// this case, the document is attached again with the local changes.
+		if converter.ErrorCodeOf(err) != "ErrDocumentNotAttached" {
+			return err
+		}
+
+		if docID, err = c.attachDocument(ctx, doc); err != nil {
+			return err
+		}
+	}
+
+	if doc.Status() == document.StatusRemoved {
+		return c.options.Store.DeleteDocument(doc.Key())
+	}
+
+	return c.addAttachment(ctx, doc, docID, opts)
+}
+
+// attachDocument sends the local changes of the given document to the server
+// with AttachDocument, and applies the response to the document. It returns
+// the ID of the document in the server.
+func (c *Client) attachDocument(ctx context.Context, doc *document.Document) (types.ID, error) {
+	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
+	if err != nil {
+		return "", err
+	}
+
 	res, err := c.client.AttachDocument(
 		ctx,
 		withShardKey(connect.NewRequest(&api.AttachDocumentRequest{
@@ -312,16 +475,16 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		},
 		), c.options.APIKey, doc.Key().String()))
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	pack, err := converter.FromChangePack(res.Msg.ChangePack)
 	if err != nil {
-		return err
+		return "", err
 	}
 
 	if err := doc.ApplyChangePack(pack); err != nil {
-		return err
+		return "", err
 	}
 	if c.logger.Core().Enabled(zap.DebugLevel) {
 		c.logger.Debug(fmt.Sprintf(
@@ -331,28 +494,48 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		))
 	}
 
-	if doc.Status() == document.StatusRemoved {
-		return nil
-	}
+	return types.ID(res.Msg.DocumentId), nil
+}
 
+// addAttachment adds the attachment of the given document attached to the
+// server, and starts watching and persisting the document.
+func (c *Client) addAttachment(
+	ctx context.Context,
+	doc *document.Document,
+	docID types.ID,
+	opts *AttachOptions,
+) error {
 	doc.SetStatus(document.StatusAttached)
-	c.attachments[doc.Key()] = &Attachment{
+	attachment := &Attachment{
 		doc:   doc,
-		docID: types.ID(res.Msg.DocumentId),
+		docID: docID,
 	}
+	c.attachments.Set(doc.Key(), attachment)
 
 	watchCtx, cancelFunc := context.WithCancel(ctx)
-	c.attachments[doc.Key()].watchCtx = watchCtx
-	c.attachments[doc.Key()].closeWatchStream = cancelFunc
+	attachment.watchCtx = watchCtx
+	attachment.closeWatchStream = cancelFunc
+
+	if opts.AutoSync {
+		attachment.syncManager = newSyncManager(c, attachment, c.options.SyncDebounce)
+		attachment.syncManager.start(watchCtx)
+	}
+
+	if c.options.Store != nil {
+		attachment.unsubscribeChanges = doc.SubscribeChanges(func(e document.DocEvent) {
+			if e.Type == document.LocalChangeEvent {
+				c.schedulePersist(attachment)
+			}
+		})
+	}
 
 	if opts.IsRealtime {
-		err = c.runWatchLoop(watchCtx, doc)
-		if err != nil {
+		if err := c.runWatchLoop(watchCtx, doc); err != nil {
 			return err
 		}
 	}
 
-	if err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
 		for k, v := range opts.InitialRoot {
 			if root.Get(k) == nil {
 				root.SetDynamicValue(k, v)
@@ -363,7 +546,84 @@ func (c *Client) Attach(ctx context.Context, doc *document.Document, options ...
 		return err
 	}
 
-	return nil
+	return c.persist(attachment)
+}
+
+// schedulePersist schedules persisting the given attachment after the
+// debounce, so that the snapshot of the document is not stored for every
+// local change. The local changes made during the debounce are persisted
+// together.
+func (c *Client) schedulePersist(attachment *Attachment) {
+	debounce := c.options.PersistDebounce
+	if debounce == 0 {
+		debounce = DefaultPersistDebounce
+	}
+
+	attachment.persistMu.Lock()
+	defer attachment.persistMu.Unlock()
+
+	if attachment.persistTimer != nil || attachment.unpersisted {
+		return
+	}
+
+	attachment.persistTimer = gotime.AfterFunc(debounce, func() {
+		if err := c.persist(attachment); err != nil {
+			c.logger.Error("persist local changes", zap.Error(err))
+		}
+	})
+}
+
+// persist stores the state of the given attachment to the store. It does
+// nothing if the store is not set.
+func (c *Client) persist(attachment *Attachment) error {
+	if c.options.Store == nil {
+		return nil
+	}
+
+	attachment.persistMu.Lock()
+	defer attachment.persistMu.Unlock()
+
+	if attachment.unpersisted {
+		return nil
+	}
+	if attachment.persistTimer != nil {
+		attachment.persistTimer.Stop()
+		attachment.persistTimer = nil
+	}
+
+	local, err := attachment.doc.LocalState()
+	if err != nil {
+		return err
+	}
+
+	return c.options.Store.SaveDocument(&DocumentState{
+		Key:   attachment.doc.Key(),
+		ID:    attachment.docID,
+		Local: local,
+	})
+}
+
+// unpersist stops persisting the given attachment and deletes its state from
+// the store. It does nothing if the store is not set.
+func (c *Client) unpersist(attachment *Attachment) error {
+	if c.options.Store == nil {
+		return nil
+	}
+
+	if attachment.unsubscribeChanges != nil {
+		attachment.unsubscribeChanges()
+	}
+
+	attachment.persistMu.Lock()
+	defer attachment.persistMu.Unlock()
+
+	attachment.unpersisted = true
+	if attachment.persistTimer != nil {
+		attachment.persistTimer.Stop()
+		attachment.persistTimer = nil
+	}
+
+	return c.options.Store.DeleteDocument(attachment.doc.Key())
 }
 
 // Detach detaches the given document from this client. It tells the
@@ -382,11 +642,14 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 		opt(opts)
 	}
 
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return ErrDocumentNotAttached
 	}
 
+	if attachment.syncManager != nil {
+		attachment.syncManager.stop()
+	}
 	attachment.closeWatchStream()
 
 	if err := doc.Update(func(root *json.Object, p *presence.Presence) error {
@@ -425,9 +688,9 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 	if doc.Status() != document.StatusRemoved {
 		doc.SetStatus(document.StatusDetached)
 	}
-	delete(c.attachments, doc.Key())
+	c.removeAttachment(doc.Key())
 
-	return nil
+	return c.unpersist(attachment)
 }
 
 // Sync pushes local changes of the attached documents to the server and
@@ -435,7 +698,7 @@ func (c *Client) Detach(ctx context.Context, doc *document.Document, options ...
 // local documents.
 func (c *Client) Sync(ctx context.Context, options ...SyncOptions) error {
 	if len(options) == 0 {
-		for _, attachment := range c.attachments {
+		for _, attachment := range c.attachments.Values() {
 			options = append(options, WithDocKey(attachment.doc.Key()))
 		}
 	}
@@ -453,7 +716,7 @@ func (c *Client) Sync(ctx context.Context, options ...SyncOptions) error {
 func (c *Client) Subscribe(
 	doc *document.Document,
 ) (<-chan WatchResponse, context.CancelFunc, error) {
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return nil, nil, ErrDocumentNotAttached
 	}
@@ -471,7 +734,7 @@ func (c *Client) Watch(ctx context.Context, doc *document.Document) (
 	*connect.ServerStreamForClient[api.WatchDocumentResponse],
 	error,
 ) {
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return nil, ErrDocumentNotAttached
 	}
@@ -494,7 +757,7 @@ func (c *Client) runWatchLoop(
 	ctx context.Context,
 	doc *document.Document,
 ) error {
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return ErrDocumentNotAttached
 	}
@@ -530,6 +793,9 @@ func (c *Client) runWatchLoop(
 				close(rch)
 				return
 			}
+			if resp != nil && resp.Type == DocumentChanged && attachment.syncManager != nil {
+				attachment.syncManager.request()
+			}
 			if resp == nil || !attachment.isSubscribed.Load() {
 				continue
 			}
@@ -565,12 +831,17 @@ func (c *Client) runWatchLoop(
 			select {
 			case e := <-doc.Events():
 				t := PresenceChanged
-				if e.Type == document.WatchedEvent {
+				switch e.Type {
+				case document.WatchedEvent:
 					t = DocumentWatched
-				} else if e.Type == document.UnwatchedEvent {
+				case document.UnwatchedEvent:
 					t = DocumentUnwatched
+				case document.LocalChangeEvent:
+					t = LocalChanged
+				case document.RemoteChangeEvent:
+					t = RemoteChanged
 				}
-				rch <- WatchResponse{Type: t, Presences: e.Presences}
+				rch <- WatchResponse{Type: t, Presences: e.Presences, Change: e.Change}
 			case <-ctx.Done():
 				return
 			}
@@ -686,11 +957,47 @@ func (c *Client) pushPullChanges(ctx context.Context, opt SyncOptions) error {
 		return ErrClientNotActivated
 	}
 
-	attachment, ok := c.attachments[opt.key]
+	attachment, ok := c.attachments.Get(opt.key)
 	if !ok {
 		return ErrDocumentNotAttached
 	}
 
+	if err := c.pushPull(ctx, attachment, opt.mode); err != nil {
+		return err
+	}
+	if attachment.doc.Status() == document.StatusRemoved {
+		if attachment.syncManager != nil {
+			attachment.syncManager.stop()
+		}
+		return c.cleanUpRemoved(attachment)
+	}
+
+	return nil
+}
+
+// cleanUpRemoved removes the attachment of the removed document from this
+// client and deletes the state of the document from the store.
+func (c *Client) cleanUpRemoved(attachment *Attachment) error {
+	c.attachments.Delete(attachment.doc.Key(), func(a *Attachment, exists bool) bool {
+		return exists && a == attachment
+	})
+
+	return c.unpersist(attachment)
+}
+
+// removeAttachment removes the attachment of the given document.
+func (c *Client) removeAttachment(k key.Key) {
+	c.attachments.Delete(k, func(_ *Attachment, _ bool) bool {
+		return true
+	})
+}
+
+// pushPull pushes the local changes of the given attachment to the server and
+// applies the changes pulled from the server to the document.
+func (c *Client) pushPull(ctx context.Context, attachment *Attachment, mode types.SyncMode) error {
+	attachment.syncMu.Lock()
+	defer attachment.syncMu.Unlock()
+
 	pbChangePack, err := converter.ToChangePack(attachment.doc.CreateChangePack())
 	if err != nil {
 		return err
@@ -702,9 +1009,9 @@ func (c *Client) pushPullChanges(ctx context.Context, opt SyncOptions) error {
 			ClientId:   c.id.String(),
 			DocumentId: attachment.docID.String(),
 			ChangePack: pbChangePack,
-			PushOnly:   opt.mode == types.SyncModePushOnly,
+			PushOnly:   mode == types.SyncModePushOnly,
 		},
-		), c.options.APIKey, opt.key.String()))
+		), c.options.APIKey, attachment.doc.Key().String()))
 	if err != nil {
 		return err
 	}
@@ -718,23 +1025,57 @@ func (c *Client) pushPullChanges(ctx context.Context, opt SyncOptions) error {
 		return err
 	}
 	if attachment.doc.Status() == document.StatusRemoved {
-		delete(c.attachments, attachment.doc.Key())
+		return nil
+	}
+
+	return c.persist(attachment)
+}
+
+// ChangeSyncMode changes the mode of the background synchronization of the
+// given document attached with WithAutoSync. SyncModeManual pauses the
+// synchronization, and SyncModeRealtime resumes it.
+func (c *Client) ChangeSyncMode(doc *document.Document, mode SyncMode) error {
+	attachment, ok := c.attachments.Get(doc.Key())
+	if !ok {
+		return ErrDocumentNotAttached
+	}
+	if attachment.syncManager == nil {
+		return ErrAutoSyncDisabled
 	}
 
+	attachment.syncManager.setMode(mode)
 	return nil
 }
 
+// SyncEvents returns the channel of the events of the background
+// synchronization of the given document attached with WithAutoSync.
+func (c *Client) SyncEvents(doc *document.Document) (<-chan SyncEvent, error) {
+	attachment, ok := c.attachments.Get(doc.Key())
+	if !ok {
+		return nil, ErrDocumentNotAttached
+	}
+	if attachment.syncManager == nil {
+		return nil, ErrAutoSyncDisabled
+	}
+
+	return attachment.syncManager.events, nil
+}
+
 // Remove removes the given document.
 func (c *Client) Remove(ctx context.Context, doc *document.Document) error {
 	if c.status != activated {
 		return ErrClientNotActivated
 	}
 
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return ErrDocumentNotAttached
 	}
 
+	if attachment.syncManager != nil {
+		attachment.syncManager.stop()
+	}
+
 	pbChangePack, err := converter.ToChangePack(doc.CreateChangePack())
 	if err != nil {
 		return err
@@ -762,7 +1103,7 @@ func (c *Client) Remove(ctx context.Context, doc *document.Document) error {
 		return err
 	}
 	if doc.Status() == document.StatusRemoved {
-		delete(c.attachments, doc.Key())
+		return c.cleanUpRemoved(attachment)
 	}
 
 	return nil
@@ -773,7 +1114,7 @@ func (c *Client) broadcast(ctx context.Context, doc *document.Document, topic st
 		return ErrClientNotActivated
 	}
 
-	attachment, ok := c.attachments[doc.Key()]
+	attachment, ok := c.attachments.Get(doc.Key())
 	if !ok {
 		return ErrDocumentNotAttached
 	}
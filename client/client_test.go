@@ -34,6 +34,10 @@ import (
 	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
 	"github.com/yorkie-team/yorkie/api/yorkie/v1/v1connect"
 	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
 type testYorkieServer struct {
@@ -108,4 +112,49 @@ func TestClient(t *testing.T) {
 		assert.NoError(t, err)
 		assert.NoError(t, cli.Activate(context.Background()))
 	})
+
+	t.Run("file store test", func(t *testing.T) {
+		store, err := client.NewFileStore(t.TempDir())
+		assert.NoError(t, err)
+
+		// clients opened with the same store have the same key
+		cli1, err := client.New(client.WithStore(store))
+		assert.NoError(t, err)
+		cli2, err := client.New(client.WithStore(store))
+		assert.NoError(t, err)
+		assert.Equal(t, cli1.Key(), cli2.Key())
+
+		state, err := store.LoadDocument("doc-1")
+		assert.NoError(t, err)
+		assert.Nil(t, state)
+
+		actor, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		doc := document.New("doc-1")
+		doc.SetActor(actor)
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "hello")
+			return nil
+		}))
+		local, err := doc.LocalState()
+		assert.NoError(t, err)
+		assert.NoError(t, store.SaveDocument(&client.DocumentState{
+			Key:   doc.Key(),
+			ID:    "000000000000000000000002",
+			Local: local,
+		}))
+
+		state, err = store.LoadDocument("doc-1")
+		assert.NoError(t, err)
+		assert.Equal(t, types.ID("000000000000000000000002"), state.ID)
+		restored := document.New("doc-1")
+		assert.NoError(t, restored.ApplyLocalState(state.Local))
+		assert.Equal(t, doc.Marshal(), restored.Marshal())
+		assert.Equal(t, doc.CreateChangePack().ChangesLen(), restored.CreateChangePack().ChangesLen())
+
+		assert.NoError(t, store.DeleteDocument("doc-1"))
+		state, err = store.LoadDocument("doc-1")
+		assert.NoError(t, err)
+		assert.Nil(t, state)
+	})
 }
@@ -0,0 +1,228 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"os"
+	"path/filepath"
+
+	"google.golang.org/protobuf/proto"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	api "github.com/yorkie-team/yorkie/api/yorkie/v1"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+const (
+	// clientKeyFileName is the name of the file that has the key of the client.
+	clientKeyFileName = "client-key"
+
+	// documentFileExt is the extension of the files that have the states of
+	// the documents.
+	documentFileExt = ".json"
+)
+
+// FileStore is a Store that keeps the states in the files of the given
+// directory. The state of each document is stored in its own file named after
+// the key of the document.
+type FileStore struct {
+	dir string
+}
+
+// fileDocumentState is the encoding of DocumentState in the file. The local
+// state of the document is encoded as Protobuf formats.
+type fileDocumentState struct {
+	ID       string `json:"id"`
+	Pack     []byte `json:"pack"`
+	ChangeID []byte `json:"changeId"`
+}
+
+// NewFileStore creates a new instance of FileStore with the given directory.
+// The directory is created if it does not exist.
+func NewFileStore(dir string) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0700); err != nil {
+		return nil, fmt.Errorf("create store directory %q: %w", dir, err)
+	}
+
+	return &FileStore{dir: dir}, nil
+}
+
+// LoadClientKey returns the key of the client.
+func (s *FileStore) LoadClientKey() (string, error) {
+	data, err := s.readFile(clientKeyFileName)
+	if err != nil || data == nil {
+		return "", err
+	}
+
+	return string(data), nil
+}
+
+// SaveClientKey stores the given key of the client.
+func (s *FileStore) SaveClientKey(clientKey string) error {
+	return s.writeFile(clientKeyFileName, []byte(clientKey))
+}
+
+// LoadDocument returns the state of the document of the given key.
+func (s *FileStore) LoadDocument(docKey key.Key) (*DocumentState, error) {
+	if err := docKey.Validate(); err != nil {
+		return nil, err
+	}
+
+	data, err := s.readFile(docKey.String() + documentFileExt)
+	if err != nil || data == nil {
+		return nil, err
+	}
+
+	var encoded fileDocumentState
+	if err := gojson.Unmarshal(data, &encoded); err != nil {
+		return nil, fmt.Errorf("unmarshal state of %s: %w", docKey, err)
+	}
+
+	pbPack := &api.ChangePack{}
+	if err := proto.Unmarshal(encoded.Pack, pbPack); err != nil {
+		return nil, fmt.Errorf("unmarshal pack of %s: %w", docKey, err)
+	}
+	pack, err := converter.FromChangePack(pbPack)
+	if err != nil {
+		return nil, err
+	}
+
+	pbChangeID := &api.ChangeID{}
+	if err := proto.Unmarshal(encoded.ChangeID, pbChangeID); err != nil {
+		return nil, fmt.Errorf("unmarshal change id of %s: %w", docKey, err)
+	}
+	changeID, err := converter.FromChangeID(pbChangeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return &DocumentState{
+		Key: docKey,
+		ID:  types.ID(encoded.ID),
+		Local: &document.LocalState{
+			Snapshot:     pack.Snapshot,
+			Checkpoint:   pack.Checkpoint,
+			ChangeID:     changeID,
+			LocalChanges: pack.Changes,
+		},
+	}, nil
+}
+
+// SaveDocument stores the given state of the document.
+func (s *FileStore) SaveDocument(state *DocumentState) error {
+	if err := state.Key.Validate(); err != nil {
+		return err
+	}
+
+	pbPack, err := converter.ToChangePack(change.NewPack(
+		state.Key,
+		state.Local.Checkpoint,
+		state.Local.LocalChanges,
+		nil,
+		state.Local.Snapshot,
+	))
+	if err != nil {
+		return err
+	}
+	pack, err := proto.Marshal(pbPack)
+	if err != nil {
+		return fmt.Errorf("marshal pack of %s: %w", state.Key, err)
+	}
+
+	pbChangeID, err := converter.ToChangeID(state.Local.ChangeID)
+	if err != nil {
+		return err
+	}
+	changeID, err := proto.Marshal(pbChangeID)
+	if err != nil {
+		return fmt.Errorf("marshal change id of %s: %w", state.Key, err)
+	}
+
+	data, err := gojson.Marshal(&fileDocumentState{
+		ID:       state.ID.String(),
+		Pack:     pack,
+		ChangeID: changeID,
+	})
+	if err != nil {
+		return fmt.Errorf("marshal state of %s: %w", state.Key, err)
+	}
+
+	return s.writeFile(state.Key.String()+documentFileExt, data)
+}
+
+// DeleteDocument deletes the state of the document of the given key.
+func (s *FileStore) DeleteDocument(docKey key.Key) error {
+	if err := docKey.Validate(); err != nil {
+		return err
+	}
+
+	path := filepath.Join(s.dir, docKey.String()+documentFileExt)
+	if err := os.Remove(path); err != nil && !errors.Is(err, os.ErrNotExist) {
+		return fmt.Errorf("remove %q: %w", path, err)
+	}
+
+	return nil
+}
+
+// readFile returns the contents of the given file in the directory. If the
+// file does not exist, it returns nil.
+func (s *FileStore) readFile(name string) ([]byte, error) {
+	path := filepath.Join(s.dir, name)
+	data, err := os.ReadFile(filepath.Clean(path))
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, fmt.Errorf("read %q: %w", path, err)
+	}
+
+	return data, nil
+}
+
+// writeFile writes the given data to the given file in the directory. The data
+// is written to a temporary file first and then renamed, so that the file is
+// not corrupted even if the client stops while writing.
+func (s *FileStore) writeFile(name string, data []byte) error {
+	tmp, err := os.CreateTemp(s.dir, name+".*.tmp")
+	if err != nil {
+		return fmt.Errorf("create temporary file of %q: %w", name, err)
+	}
+	defer func() {
+		_ = os.Remove(tmp.Name())
+	}()
+
+	if _, err := tmp.Write(data); err != nil {
+		_ = tmp.Close()
+		return fmt.Errorf("write %q: %w", tmp.Name(), err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("close %q: %w", tmp.Name(), err)
+	}
+
+	path := filepath.Join(s.dir, name)
+	if err := os.Rename(tmp.Name(), path); err != nil {
+		return fmt.Errorf("rename %q to %q: %w", tmp.Name(), path, err)
+	}
+
+	return nil
+}
@@ -17,6 +17,8 @@
 package client
 
 import (
+	"time"
+
 	"go.uber.org/zap"
 
 	"github.com/yorkie-team/yorkie/api/types"
@@ -49,6 +51,20 @@ type Options struct {
 
 	// MaxCallRecvMsgSize is the maximum message size in bytes the client can receive.
 	MaxCallRecvMsgSize int
+
+	// Store is the local storage of the client. If it is set, the states of
+	// the attached documents are persisted to resume them after restart.
+	Store Store
+
+	// SyncDebounce is the time to wait for more local changes before pushing
+	// them in the background synchronization. The default is
+	// DefaultSyncDebounce.
+	SyncDebounce time.Duration
+
+	// PersistDebounce is the time to wait for more local changes before
+	// persisting the document to the store. The default is
+	// DefaultPersistDebounce.
+	PersistDebounce time.Duration
 }
 
 // WithKey configures the key of the client.
@@ -86,6 +102,25 @@ func WithMaxRecvMsgSize(maxRecvMsgSize int) Option {
 	return func(o *Options) { o.MaxCallRecvMsgSize = maxRecvMsgSize }
 }
 
+// WithStore configures the local storage of the client. If the key of the
+// client is not given, the key kept in the store is used, so that the client
+// is re-opened with the same actor.
+func WithStore(store Store) Option {
+	return func(o *Options) { o.Store = store }
+}
+
+// WithSyncDebounce configures the time to wait for more local changes before
+// pushing them in the background synchronization.
+func WithSyncDebounce(debounce time.Duration) Option {
+	return func(o *Options) { o.SyncDebounce = debounce }
+}
+
+// WithPersistDebounce configures the time to wait for more local changes
+// before persisting the document to the store.
+func WithPersistDebounce(debounce time.Duration) Option {
+	return func(o *Options) { o.PersistDebounce = debounce }
+}
+
 // AttachOption configures AttachOptions.
 type AttachOption func(*AttachOptions)
 
@@ -95,6 +130,7 @@ type AttachOptions struct {
 	Presence    innerpresence.Presence
 	InitialRoot map[string]any
 	IsRealtime  bool
+	AutoSync    bool
 }
 
 // WithPresence configures the presence of the client.
@@ -116,6 +152,16 @@ func WithRealtimeSync() AttachOption {
 	return func(o *AttachOptions) { o.IsRealtime = true }
 }
 
+// WithAutoSync configures the document to be synchronized in background. The
+// local changes are pushed with debouncing and the remote changes are pulled
+// when the document is changed by others. It implies WithRealtimeSync.
+func WithAutoSync() AttachOption {
+	return func(o *AttachOptions) {
+		o.IsRealtime = true
+		o.AutoSync = true
+	}
+}
+
 // DetachOption configures DetachOptions.
 type DetachOption func(*DetachOptions)
 
@@ -0,0 +1,58 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+// Store is the local storage of the client. It keeps the key of the client and
+// the states of the attached documents, so that the client can be re-opened
+// with the same actor and resume pushing the local changes after restart.
+type Store interface {
+	// LoadClientKey returns the key of the client. If the key is not stored,
+	// it returns an empty string.
+	LoadClientKey() (string, error)
+
+	// SaveClientKey stores the given key of the client.
+	SaveClientKey(clientKey string) error
+
+	// LoadDocument returns the state of the document of the given key. If the
+	// state is not stored, it returns nil.
+	LoadDocument(docKey key.Key) (*DocumentState, error)
+
+	// SaveDocument stores the given state of the document.
+	SaveDocument(state *DocumentState) error
+
+	// DeleteDocument deletes the state of the document of the given key.
+	DeleteDocument(docKey key.Key) error
+}
+
+// DocumentState is the state of the document attached to the client.
+type DocumentState struct {
+	// Key is the key of the document.
+	Key key.Key
+
+	// ID is the ID of the document in the server.
+	ID types.ID
+
+	// Local is the local state of the document, such as the snapshot, the
+	// checkpoint and the local changes that are not yet sent to the server.
+	Local *document.LocalState
+}
@@ -0,0 +1,311 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package client
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"connectrpc.com/connect"
+	"go.uber.org/zap"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
+)
+
+const (
+	// DefaultSyncDebounce is the default time to wait for more local changes
+	// before pushing them to the server.
+	DefaultSyncDebounce = 100 * time.Millisecond
+
+	// syncRetryInterval is the time to wait before retrying the failed sync
+	// for the first time. It is doubled for each consecutive failure.
+	syncRetryInterval = time.Second
+
+	// maxSyncRetryInterval is the maximum time to wait before retrying the
+	// failed sync.
+	maxSyncRetryInterval = 30 * time.Second
+)
+
+// SyncMode is the mode of the synchronization of the document attached with
+// WithAutoSync.
+type SyncMode string
+
+const (
+	// SyncModeRealtime means that the local changes are pushed and the remote
+	// changes are pulled in background.
+	SyncModeRealtime SyncMode = "realtime"
+
+	// SyncModeManual means that the background synchronization is paused, and
+	// the document is synchronized only when Sync is called.
+	SyncModeManual SyncMode = "manual"
+)
+
+// SyncStatus is the status of the synchronization of the document.
+type SyncStatus string
+
+const (
+	// SyncStatusSynced means that the document has been synchronized with the
+	// server.
+	SyncStatusSynced SyncStatus = "synced"
+
+	// SyncStatusFailed means that the synchronization has failed. It is
+	// retried later.
+	SyncStatusFailed SyncStatus = "sync-failed"
+
+	// SyncStatusStopped means that the synchronization has failed with an
+	// error that retrying cannot resolve, e.g. the pushed changes are not
+	// allowed. The mode is changed to SyncModeManual, and the synchronization
+	// can be resumed with SyncModeRealtime after the cause is resolved.
+	SyncStatusStopped SyncStatus = "sync-stopped"
+)
+
+// SyncEvent is an event of the background synchronization of the document.
+type SyncEvent struct {
+	Status SyncStatus
+	Err    error
+}
+
+// syncManager synchronizes the attached document in background. It pushes the
+// local changes after no more changes are made for the debounce time, and
+// pulls the remote changes when DocumentChanged is received from the watch
+// stream.
+type syncManager struct {
+	client     *Client
+	attachment *Attachment
+	debounce   time.Duration
+
+	mu    sync.Mutex
+	mode  SyncMode
+	timer *time.Timer
+
+	// retryInterval is the time to wait before retrying the failed sync. It
+	// is reset when the sync succeeds.
+	retryInterval time.Duration
+
+	// requests is the channel to request the sync loop to synchronize. The
+	// requests made while the loop is synchronizing are merged into one.
+	requests chan struct{}
+
+	// events is the channel to send the sync events. The oldest event is
+	// dropped if the channel is full, so the latest status is always kept.
+	events chan SyncEvent
+
+	unsubscribe func()
+	cancel      context.CancelFunc
+	done        chan struct{}
+}
+
+// newSyncManager creates a new instance of syncManager of the given attachment.
+func newSyncManager(c *Client, attachment *Attachment, debounce time.Duration) *syncManager {
+	if debounce == 0 {
+		debounce = DefaultSyncDebounce
+	}
+
+	return &syncManager{
+		client:        c,
+		attachment:    attachment,
+		debounce:      debounce,
+		mode:          SyncModeRealtime,
+		retryInterval: syncRetryInterval,
+		requests:      make(chan struct{}, 1),
+		events:        make(chan SyncEvent, 16),
+		done:          make(chan struct{}),
+	}
+}
+
+// start starts the sync loop. The loop is stopped when the given context is
+// done or stop is called.
+func (m *syncManager) start(ctx context.Context) {
+	ctx, m.cancel = context.WithCancel(ctx)
+	m.attachment.doc.SetBackgroundSync(true)
+	m.unsubscribe = m.attachment.doc.SubscribeChanges(func(e document.DocEvent) {
+		if e.Type == document.LocalChangeEvent {
+			m.schedule(m.debounce)
+		}
+	})
+
+	go m.run(ctx)
+}
+
+// stop stops the sync loop and waits for the loop to finish.
+func (m *syncManager) stop() {
+	m.cancel()
+	m.unsubscribe()
+
+	m.mu.Lock()
+	if m.timer != nil {
+		m.timer.Stop()
+	}
+	m.mu.Unlock()
+
+	<-m.done
+	m.attachment.doc.SetBackgroundSync(false)
+}
+
+// setMode sets the mode of the synchronization. When the mode is changed to
+// realtime, the document is synchronized immediately.
+func (m *syncManager) setMode(mode SyncMode) {
+	m.mu.Lock()
+	m.mode = mode
+	if mode == SyncModeManual && m.timer != nil {
+		m.timer.Stop()
+	}
+	if mode == SyncModeRealtime {
+		m.retryInterval = syncRetryInterval
+	}
+	m.mu.Unlock()
+
+	if mode == SyncModeRealtime {
+		m.request()
+	}
+}
+
+// isRealtime returns whether the mode of the synchronization is realtime.
+func (m *syncManager) isRealtime() bool {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	return m.mode == SyncModeRealtime
+}
+
+// schedule requests the sync loop to synchronize after the given delay. The
+// previous request that is not yet made is canceled.
+func (m *syncManager) schedule(delay time.Duration) {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	if m.mode != SyncModeRealtime {
+		return
+	}
+
+	if m.timer != nil {
+		m.timer.Stop()
+	}
+	m.timer = time.AfterFunc(delay, m.request)
+}
+
+// request requests the sync loop to synchronize immediately.
+func (m *syncManager) request() {
+	select {
+	case m.requests <- struct{}{}:
+	default:
+	}
+}
+
+// run runs the sync loop.
+func (m *syncManager) run(ctx context.Context) {
+	defer close(m.done)
+
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-m.requests:
+		}
+
+		if !m.isRealtime() {
+			continue
+		}
+
+		err := m.client.pushPull(ctx, m.attachment, types.SyncModePushPull)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if err != nil {
+			if isPermanentSyncError(err) {
+				m.setMode(SyncModeManual)
+				m.publish(SyncEvent{Status: SyncStatusStopped, Err: err})
+				continue
+			}
+
+			m.publish(SyncEvent{Status: SyncStatusFailed, Err: err})
+			m.schedule(m.backoff())
+			continue
+		}
+		m.resetBackoff()
+		m.publish(SyncEvent{Status: SyncStatusSynced})
+
+		// NOTE: The removed document can no longer be synchronized, so the
+		// loop is finished.
+		if m.attachment.doc.Status() == document.StatusRemoved {
+			if err := m.client.cleanUpRemoved(m.attachment); err != nil {
+				m.client.logger.Error("clean up removed document", zap.Error(err))
+			}
+			return
+		}
+	}
+}
+
+// backoff returns the time to wait before retrying the failed sync and
+// doubles it for the next failure.
+func (m *syncManager) backoff() time.Duration {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	interval := m.retryInterval
+	m.retryInterval = min(m.retryInterval*2, maxSyncRetryInterval)
+	return interval
+}
+
+// resetBackoff resets the time to wait before retrying the failed sync.
+func (m *syncManager) resetBackoff() {
+	m.mu.Lock()
+	defer m.mu.Unlock()
+
+	m.retryInterval = syncRetryInterval
+}
+
+// isPermanentSyncError returns whether the given error of the sync cannot be
+// resolved by retrying, e.g. the pushed changes violate the schema or write
+// to the path not allowed to the user.
+func isPermanentSyncError(err error) bool {
+	switch connect.CodeOf(err) {
+	case connect.CodeUnauthenticated,
+		connect.CodePermissionDenied,
+		connect.CodeInvalidArgument,
+		connect.CodeUnimplemented:
+		return true
+	}
+
+	switch converter.ErrorCodeOf(err) {
+	case "ErrSchemaViolation", "ErrClientNotActivated", "ErrDocumentNotAttached":
+		return true
+	}
+
+	return false
+}
+
+// publish sends the given event to the events channel. If the channel is full,
+// the oldest event is dropped.
+func (m *syncManager) publish(e SyncEvent) {
+	for {
+		select {
+		case m.events <- e:
+			return
+		default:
+		}
+
+		select {
+		case <-m.events:
+		default:
+		}
+	}
+}
@@ -0,0 +1,135 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/units"
+)
+
+func newBlameCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "blame [project name] [document key]",
+		Short:   "Show who last wrote each element and each span of text of a document",
+		Example: "yorkie document blame sample-project sample-document",
+		PreRunE: config.Preload,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := args[1]
+
+			rpcAddr := viper.GetString("rpcAddr")
+			auth, err := config.LoadAuth(rpcAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(rpcAddr, admin.WithToken(auth.Token), admin.WithInsecure(auth.Insecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				cli.Close()
+			}()
+
+			ctx := context.Background()
+			attrs, err := cli.BlameDocument(ctx, projectName, key.Key(documentKey))
+			if err != nil {
+				return err
+			}
+
+			output := viper.GetString("output")
+			if err := printAttributions(cmd, output, attrs); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func printAttributions(cmd *cobra.Command, output string, attrs []*crdt.Attribution) error {
+	switch output {
+	case "":
+		tw := table.NewWriter()
+		tw.Style().Options.DrawBorder = false
+		tw.Style().Options.SeparateColumns = false
+		tw.Style().Options.SeparateFooter = false
+		tw.Style().Options.SeparateHeader = false
+		tw.Style().Options.SeparateRows = false
+		tw.AppendHeader(table.Row{
+			"PATH",
+			"CONTENT",
+			"AUTHOR",
+			"SERVER SEQ",
+			"UPDATED AT",
+		})
+		for _, attr := range attrs {
+			content := ""
+			if attr.Content != "" {
+				content = fmt.Sprintf("%d: %q", attr.Index, attr.Content)
+			}
+			updatedAt := ""
+			if !attr.UpdatedAt.IsZero() {
+				updatedAt = units.HumanDuration(time.Now().UTC().Sub(attr.UpdatedAt))
+			}
+			tw.AppendRow(table.Row{
+				attr.Path,
+				content,
+				attr.Author,
+				attr.ServerSeq,
+				updatedAt,
+			})
+		}
+		cmd.Printf("%s\n", tw.Render())
+	case "json":
+		jsonOutput, err := json.MarshalIndent(attrs, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal JSON: %w", err)
+		}
+		cmd.Println(string(jsonOutput))
+	case "yaml":
+		yamlOutput, err := yaml.Marshal(attrs)
+		if err != nil {
+			return fmt.Errorf("marshal YAML: %w", err)
+		}
+		cmd.Println(string(yamlOutput))
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+
+	return nil
+}
+
+func init() {
+	SubCmd.AddCommand(newBlameCommand())
+}
@@ -0,0 +1,165 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	flagFromSeq int64
+	flagToSeq   int64
+)
+
+func newDiffCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "diff [project name] [document key]",
+		Short:   "Show the differences of a document between two revisions",
+		Example: "yorkie document diff sample-project sample-document --from 10 --to 20",
+		PreRunE: config.Preload,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := args[1]
+
+			rpcAddr := viper.GetString("rpcAddr")
+			auth, err := config.LoadAuth(rpcAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(rpcAddr, admin.WithToken(auth.Token), admin.WithInsecure(auth.Insecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				cli.Close()
+			}()
+
+			ctx := context.Background()
+			diffs, err := cli.DiffDocument(ctx, projectName, key.Key(documentKey), flagFromSeq, flagToSeq)
+			if err != nil {
+				return err
+			}
+
+			output := viper.GetString("output")
+			if err := printDifferences(cmd, output, diffs); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func printDifferences(cmd *cobra.Command, output string, diffs []*crdt.Difference) error {
+	switch output {
+	case "":
+		tw := table.NewWriter()
+		tw.Style().Options.DrawBorder = false
+		tw.Style().Options.SeparateColumns = false
+		tw.Style().Options.SeparateFooter = false
+		tw.Style().Options.SeparateHeader = false
+		tw.Style().Options.SeparateRows = false
+		tw.AppendHeader(table.Row{
+			"TYPE",
+			"PATH",
+			"DETAIL",
+			"AUTHOR",
+		})
+		for _, diff := range diffs {
+			tw.AppendRow(table.Row{
+				diff.Type,
+				diff.Path,
+				describeDifference(diff),
+				diff.Author,
+			})
+		}
+		cmd.Printf("%s\n", tw.Render())
+	case "json":
+		jsonOutput, err := json.MarshalIndent(diffs, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal JSON: %w", err)
+		}
+		cmd.Println(string(jsonOutput))
+	case "yaml":
+		yamlOutput, err := yaml.Marshal(diffs)
+		if err != nil {
+			return fmt.Errorf("marshal YAML: %w", err)
+		}
+		cmd.Println(string(yamlOutput))
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+
+	return nil
+}
+
+// describeDifference returns the human-readable detail of the given
+// difference.
+func describeDifference(diff *crdt.Difference) string {
+	switch diff.Type {
+	case crdt.DiffAdd:
+		return diff.To
+	case crdt.DiffRemove:
+		return diff.From
+	case crdt.DiffChange:
+		return diff.From + " -> " + diff.To
+	case crdt.DiffMove:
+		return fmt.Sprintf("%d -> %d", diff.FromIndex, diff.ToIndex)
+	case crdt.DiffInsert, crdt.DiffDelete:
+		return fmt.Sprintf("%d: %q", diff.Index, diff.Content)
+	case crdt.DiffStyle:
+		if diff.Content != "" {
+			return fmt.Sprintf("%d: %q %s", diff.Index, diff.Content, diff.To)
+		}
+		return fmt.Sprintf("%d: %s -> %s", diff.Index, diff.From, diff.To)
+	}
+
+	return ""
+}
+
+func init() {
+	cmd := newDiffCommand()
+	cmd.Flags().Int64Var(
+		&flagFromSeq,
+		"from",
+		0,
+		"The server sequence of the old revision",
+	)
+	cmd.Flags().Int64Var(
+		&flagToSeq,
+		"to",
+		0,
+		"The server sequence of the new revision",
+	)
+	SubCmd.AddCommand(cmd)
+}
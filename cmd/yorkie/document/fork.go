@@ -0,0 +1,96 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"errors"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	flagForkSeq           int64
+	flagForkTargetProject string
+)
+
+func newForkCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "fork [project name] [document key] [new document key]",
+		Short:   "Create a new document from a revision of a document",
+		Example: "yorkie document fork sample-project sample-document new-document --seq 10",
+		PreRunE: config.Preload,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				return errors.New("project name, document key and new document key are required")
+			}
+			projectName := args[0]
+			documentKey := args[1]
+			newDocumentKey := args[2]
+
+			cli, err := dialAdmin()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				cli.Close()
+			}()
+
+			ctx := context.Background()
+			summary, err := cli.ForkDocument(
+				ctx,
+				projectName,
+				key.Key(documentKey),
+				flagForkSeq,
+				flagForkTargetProject,
+				key.Key(newDocumentKey),
+			)
+			if err != nil {
+				return err
+			}
+
+			output := viper.GetString("output")
+			if err := printDocuments(cmd, output, []*types.DocumentSummary{summary}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func init() {
+	cmd := newForkCommand()
+	cmd.Flags().Int64Var(
+		&flagForkSeq,
+		"seq",
+		0,
+		"The server sequence of the revision to fork",
+	)
+	cmd.Flags().StringVar(
+		&flagForkTargetProject,
+		"target-project",
+		"",
+		"The project to create the new document in (default: the same project)",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -0,0 +1,139 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+)
+
+var (
+	flagAtSeq  int64
+	flagAtTime string
+)
+
+func newGetCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "get [project name] [document key]",
+		Short:   "Get the contents of a document at a revision",
+		Example: "yorkie document get sample-project sample-document --at-seq 10",
+		PreRunE: config.Preload,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := args[1]
+
+			atSeqChanged := cmd.Flags().Lookup("at-seq").Changed
+			atTimeChanged := cmd.Flags().Lookup("at-time").Changed
+			if atSeqChanged && atTimeChanged {
+				return errors.New("only one of --at-seq and --at-time can be specified")
+			}
+
+			// NOTE: Without the flags, the latest revision is returned.
+			var at time.Time
+			if atTimeChanged {
+				parsed, err := time.Parse(time.RFC3339, flagAtTime)
+				if err != nil {
+					return fmt.Errorf("parse --at-time: %w", err)
+				}
+				at = parsed
+			} else if !atSeqChanged {
+				at = time.Now()
+			}
+
+			rpcAddr := viper.GetString("rpcAddr")
+			auth, err := config.LoadAuth(rpcAddr)
+			if err != nil {
+				return err
+			}
+			cli, err := admin.Dial(rpcAddr, admin.WithToken(auth.Token), admin.WithInsecure(auth.Insecure))
+			if err != nil {
+				return err
+			}
+			defer func() {
+				cli.Close()
+			}()
+
+			ctx := context.Background()
+			revision, err := cli.GetDocumentAtRevision(ctx, projectName, key.Key(documentKey), flagAtSeq, at)
+			if err != nil {
+				return err
+			}
+
+			output := viper.GetString("output")
+			if err := printDocumentRevision(cmd, output, revision); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func printDocumentRevision(cmd *cobra.Command, output string, revision *types.DocumentRevision) error {
+	switch output {
+	case "":
+		cmd.Println(revision.Root)
+	case "json":
+		jsonOutput, err := json.MarshalIndent(revision, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal JSON: %w", err)
+		}
+		cmd.Println(string(jsonOutput))
+	case "yaml":
+		yamlOutput, err := yaml.Marshal(revision)
+		if err != nil {
+			return fmt.Errorf("marshal YAML: %w", err)
+		}
+		cmd.Println(string(yamlOutput))
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+
+	return nil
+}
+
+func init() {
+	cmd := newGetCommand()
+	cmd.Flags().Int64Var(
+		&flagAtSeq,
+		"at-seq",
+		0,
+		"The server sequence of the revision",
+	)
+	cmd.Flags().StringVar(
+		&flagAtTime,
+		"at-time",
+		"",
+		"The time of the revision in RFC3339 format, e.g. 2025-01-02T15:04:05Z",
+	)
+	SubCmd.AddCommand(cmd)
+}
@@ -0,0 +1,238 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/jedib0t/go-pretty/v6/table"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+	"gopkg.in/yaml.v3"
+
+	"github.com/yorkie-team/yorkie/admin"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/cmd/yorkie/config"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/units"
+)
+
+var (
+	flagTagSeq     int64
+	flagTagMessage string
+)
+
+func newTagCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:   "tag",
+		Short: "Manage revision tags of documents",
+	}
+}
+
+func newTagCreateCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "create [project name] [document key] [tag name]",
+		Short:   "Create a tag that names a revision of a document",
+		Example: "yorkie document tag create sample-project sample-document v1.0 --seq 10 --message \"first release\"",
+		PreRunE: config.Preload,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				return errors.New("project name, document key and tag name are required")
+			}
+			projectName := args[0]
+			documentKey := args[1]
+			name := args[2]
+
+			cli, err := dialAdmin()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				cli.Close()
+			}()
+
+			ctx := context.Background()
+			tag, err := cli.CreateRevisionTag(
+				ctx,
+				projectName,
+				key.Key(documentKey),
+				name,
+				flagTagSeq,
+				flagTagMessage,
+			)
+			if err != nil {
+				return err
+			}
+
+			output := viper.GetString("output")
+			if err := printRevisionTags(cmd, output, []*types.RevisionTag{tag}); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func newTagListCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "list [project name] [document key]",
+		Short:   "List the revision tags of a document",
+		Example: "yorkie document tag list sample-project sample-document",
+		PreRunE: config.Preload,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 2 {
+				return errors.New("project name and document key are required")
+			}
+			projectName := args[0]
+			documentKey := args[1]
+
+			cli, err := dialAdmin()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				cli.Close()
+			}()
+
+			ctx := context.Background()
+			tags, err := cli.ListRevisionTags(ctx, projectName, key.Key(documentKey))
+			if err != nil {
+				return err
+			}
+
+			output := viper.GetString("output")
+			if err := printRevisionTags(cmd, output, tags); err != nil {
+				return err
+			}
+
+			return nil
+		},
+	}
+}
+
+func newTagDeleteCommand() *cobra.Command {
+	return &cobra.Command{
+		Use:     "delete [project name] [document key] [tag name]",
+		Short:   "Delete a revision tag of a document",
+		Example: "yorkie document tag delete sample-project sample-document v1.0",
+		PreRunE: config.Preload,
+		RunE: func(cmd *cobra.Command, args []string) error {
+			if len(args) != 3 {
+				return errors.New("project name, document key and tag name are required")
+			}
+			projectName := args[0]
+			documentKey := args[1]
+			name := args[2]
+
+			cli, err := dialAdmin()
+			if err != nil {
+				return err
+			}
+			defer func() {
+				cli.Close()
+			}()
+
+			ctx := context.Background()
+
+			return cli.DeleteRevisionTag(ctx, projectName, key.Key(documentKey), name)
+		},
+	}
+}
+
+// dialAdmin dials the admin server of the current context.
+func dialAdmin() (*admin.Client, error) {
+	rpcAddr := viper.GetString("rpcAddr")
+	auth, err := config.LoadAuth(rpcAddr)
+	if err != nil {
+		return nil, err
+	}
+
+	return admin.Dial(rpcAddr, admin.WithToken(auth.Token), admin.WithInsecure(auth.Insecure))
+}
+
+func printRevisionTags(cmd *cobra.Command, output string, tags []*types.RevisionTag) error {
+	switch output {
+	case "":
+		tw := table.NewWriter()
+		tw.Style().Options.DrawBorder = false
+		tw.Style().Options.SeparateColumns = false
+		tw.Style().Options.SeparateFooter = false
+		tw.Style().Options.SeparateHeader = false
+		tw.Style().Options.SeparateRows = false
+		tw.AppendHeader(table.Row{
+			"NAME",
+			"SERVER SEQ",
+			"MESSAGE",
+			"AUTHOR",
+			"CREATED AT",
+		})
+		for _, tag := range tags {
+			tw.AppendRow(table.Row{
+				tag.Name,
+				tag.ServerSeq,
+				tag.Message,
+				tag.Author,
+				units.HumanDuration(time.Now().UTC().Sub(tag.CreatedAt)),
+			})
+		}
+		cmd.Printf("%s\n", tw.Render())
+	case "json":
+		jsonOutput, err := json.MarshalIndent(tags, "", "  ")
+		if err != nil {
+			return fmt.Errorf("marshal JSON: %w", err)
+		}
+		cmd.Println(string(jsonOutput))
+	case "yaml":
+		yamlOutput, err := yaml.Marshal(tags)
+		if err != nil {
+			return fmt.Errorf("marshal YAML: %w", err)
+		}
+		cmd.Println(string(yamlOutput))
+	default:
+		return fmt.Errorf("unknown output format: %s", output)
+	}
+
+	return nil
+}
+
+func init() {
+	cmd := newTagCommand()
+
+	createCmd := newTagCreateCommand()
+	createCmd.Flags().Int64Var(
+		&flagTagSeq,
+		"seq",
+		0,
+		"The server sequence of the revision to tag",
+	)
+	createCmd.Flags().StringVar(
+		&flagTagMessage,
+		"message",
+		"",
+		"The message of the tag",
+	)
+	cmd.AddCommand(createCmd)
+	cmd.AddCommand(newTagListCommand())
+	cmd.AddCommand(newTagDeleteCommand())
+
+	SubCmd.AddCommand(cmd)
+}
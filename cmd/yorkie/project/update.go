@@ -42,6 +42,7 @@ var (
 	flagEventWebhookEventsRm      []string
 	flagName                      string
 	flagClientDeactivateThreshold string
+	flagDocumentSchema            string
 )
 
 var allAuthWebhookMethods = []string{
@@ -126,6 +127,11 @@ func newUpdateCommand() *cobra.Command {
 				newClientDeactivateThreshold = flagClientDeactivateThreshold
 			}
 
+			newDocumentSchema := project.DocumentSchema
+			if cmd.Flags().Lookup("document-schema").Changed { // allow empty string
+				newDocumentSchema = flagDocumentSchema
+			}
+
 			updatableProjectFields := &types.UpdatableProjectFields{
 				Name:                      &newName,
 				AuthWebhookURL:            &newAuthWebhookURL,
@@ -133,6 +139,7 @@ func newUpdateCommand() *cobra.Command {
 				EventWebhookURL:           &newEventWebhookURL,
 				EventWebhookEvents:        &newEventWebhookEvents,
 				ClientDeactivateThreshold: &newClientDeactivateThreshold,
+				DocumentSchema:            &newDocumentSchema,
 			}
 
 			updated, err := cli.UpdateProject(ctx, id, updatableProjectFields)
@@ -279,5 +286,11 @@ func init() {
 		"",
 		"client deactivate threshold for housekeeping",
 	)
+	cmd.Flags().StringVar(
+		&flagDocumentSchema,
+		"document-schema",
+		"",
+		"JSON schema that the root of documents should conform to",
+	)
 	SubCmd.AddCommand(cmd)
 }
@@ -59,6 +59,7 @@ var (
 	eventWebhookCacheTTL        time.Duration
 
 	projectCacheTTL time.Duration
+	docCacheTTL     time.Duration
 
 	kafkaAddresses    string
 	kafkaTopic        string
@@ -86,6 +87,7 @@ func newServerCmd() *cobra.Command {
 			conf.Backend.EventWebhookRequestTimeout = eventWebhookRequestTimeout.String()
 
 			conf.Backend.ProjectCacheTTL = projectCacheTTL.String()
+			conf.Backend.DocCacheTTL = docCacheTTL.String()
 
 			conf.Housekeeping.Interval = housekeepingInterval.String()
 
@@ -395,6 +397,18 @@ func init() {
 		server.DefaultProjectCacheTTL,
 		"TTL value to set when caching project info.",
 	)
+	cmd.Flags().IntVar(
+		&conf.Backend.DocCacheSize,
+		"doc-cache-size",
+		server.DefaultDocCacheSize,
+		"The cache size of the documents built to validate the pushed changes.",
+	)
+	cmd.Flags().DurationVar(
+		&docCacheTTL,
+		"doc-cache-ttl",
+		server.DefaultDocCacheTTL,
+		"TTL value to set when caching the documents built to validate the pushed changes.",
+	)
 	cmd.Flags().StringVar(
 		&conf.Backend.Hostname,
 		"hostname",
@@ -64,4 +64,20 @@ func TestCache(t *testing.T) {
 		assert.True(t, ok)
 		assert.Equal(t, "response2", response2)
 	})
+
+	t.Run("remove test", func(t *testing.T) {
+		lruCache := cache.NewLRUExpireCache[string, string](2)
+		lruCache.Add("request1", "response1", time.Minute)
+		lruCache.Add("request2", "response2", time.Minute)
+
+		lruCache.Remove("request1")
+		_, ok := lruCache.Get("request1")
+		assert.False(t, ok)
+		response2, ok := lruCache.Get("request2")
+		assert.True(t, ok)
+		assert.Equal(t, "response2", response2)
+
+		// removing the key that does not exist should be ignored
+		lruCache.Remove("request1")
+	})
 }
@@ -51,10 +51,55 @@ func New(id ID, message string, operations []operations.Operation, p *innerprese
 	}
 }
 
+// Interceptor intercepts the execution of each operation in a change. It
+// should call the given `execute` to apply the operation, which allows it to
+// inspect the root before and after the operation is applied.
+type Interceptor func(op operations.Operation, execute func() error) error
+
+// ChainInterceptors returns an interceptor that runs the given interceptors in
+// order. Each interceptor wraps the execution of the next one, and nil
+// interceptors are skipped.
+func ChainInterceptors(interceptors ...Interceptor) Interceptor {
+	return func(op operations.Operation, execute func() error) error {
+		next := execute
+		for i := len(interceptors) - 1; i >= 0; i-- {
+			interceptor, inner := interceptors[i], next
+			if interceptor == nil {
+				continue
+			}
+			next = func() error {
+				return interceptor(op, inner)
+			}
+		}
+		return next()
+	}
+}
+
 // Execute applies this change to the given JSON root.
 func (c *Change) Execute(root *crdt.Root, presences *innerpresence.Map) error {
+	return c.ExecuteWith(root, presences, nil)
+}
+
+// ExecuteWith applies this change to the given JSON root. Each operation of
+// this change is executed through the given interceptor if it is not nil.
+func (c *Change) ExecuteWith(
+	root *crdt.Root,
+	presences *innerpresence.Map,
+	interceptor Interceptor,
+) error {
 	for _, op := range c.operations {
-		if err := op.Execute(root, c.ID().versionVector); err != nil {
+		execute := func() error {
+			return op.Execute(root, c.ID().versionVector)
+		}
+
+		if interceptor == nil {
+			if err := execute(); err != nil {
+				return err
+			}
+			continue
+		}
+
+		if err := interceptor(op, execute); err != nil {
 			return err
 		}
 	}
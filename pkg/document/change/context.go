@@ -70,9 +70,9 @@ func (c *Context) Push(op operations.Operation) {
 	c.operations = append(c.operations, op)
 }
 
-// RegisterElement registers the given element to the root.
-func (c *Context) RegisterElement(elem crdt.Element) {
-	c.root.RegisterElement(elem)
+// RegisterElement registers the given element of the given parent to the root.
+func (c *Context) RegisterElement(parent crdt.Container, elem crdt.Element) {
+	c.root.RegisterElement(parent, elem)
 }
 
 // RegisterRemovedElementPair registers the given element pair to hash table.
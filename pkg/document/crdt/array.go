@@ -17,6 +17,8 @@
 package crdt
 
 import (
+	"strconv"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
@@ -190,6 +192,21 @@ func (a *Array) Set(createdAt *time.Ticket, element Element, executedAt *time.Ti
 	return nil, nil
 }
 
+// IndexOf returns the index of the element of the given creation time.
+func (a *Array) IndexOf(createdAt *time.Ticket) (int, error) {
+	return a.elements.IndexOf(createdAt)
+}
+
+// SubPathOf returns the index of the given child element as a sub path.
+func (a *Array) SubPathOf(createdAt *time.Ticket) (string, error) {
+	idx, err := a.elements.IndexOf(createdAt)
+	if err != nil {
+		return "", err
+	}
+
+	return strconv.Itoa(idx), nil
+}
+
 // Len returns length of this Array.
 func (a *Array) Len() int {
 	return a.elements.Len()
@@ -0,0 +1,137 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"slices"
+	"strconv"
+	gotime "time"
+	"unicode/utf16"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Attribution represents the author of an element or a span of characters of
+// a root. Path is the path of the element, e.g. "$.todos.1".
+type Attribution struct {
+	Path string `json:"path"`
+
+	// Index and Content are the index and the contents of the span of
+	// characters of a text or a tree. They are empty for the element itself.
+	Index   int    `json:"index,omitempty"`
+	Content string `json:"content,omitempty"`
+
+	// Author and Lamport are the hex encoded ID of the actor who last wrote
+	// the element or the span, and the lamport timestamp of the write.
+	Author  string `json:"author"`
+	Lamport int64  `json:"lamport"`
+
+	// ServerSeq and UpdatedAt are the server sequence and the time of the
+	// change of the write. They are only set when the change history is
+	// available.
+	ServerSeq int64       `json:"serverSeq,omitempty"`
+	UpdatedAt gotime.Time `json:"updatedAt,omitempty"`
+}
+
+// Blame returns the attributions of the elements of the given root and the
+// characters of its texts and trees. The author of an element is the creator
+// of it, because replacing a member or an element creates a new one. The
+// consecutive characters of the same author are grouped into a span, and the
+// removed ones are skipped.
+func Blame(root *Root) []*Attribution {
+	b := &blamer{}
+	b.blameObject("$", root.Object())
+	return b.attrs
+}
+
+// blamer collects the attributions of a root.
+type blamer struct {
+	attrs []*Attribution
+}
+
+// blameElement appends the attributions of the given element and its
+// descendants.
+func (b *blamer) blameElement(path string, elem Element) {
+	b.attrs = append(b.attrs, newAttribution(path, elem.CreatedAt()))
+
+	switch elem := elem.(type) {
+	case *Object:
+		b.blameObject(path, elem)
+	case *Array:
+		for idx, child := range elem.Elements() {
+			b.blameElement(path+"."+strconv.Itoa(idx), child)
+		}
+	case *Text:
+		chars, _ := textChars(elem)
+		b.blameChars(path, chars)
+	case *Tree:
+		b.blameChars(path, flattenTree(elem).chars)
+	}
+}
+
+// blameObject appends the attributions of the members of the given object in
+// the order of their keys.
+func (b *blamer) blameObject(path string, obj *Object) {
+	members := obj.Members()
+	keys := make([]string, 0, len(members))
+	for k := range members {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		b.blameElement(path+"."+k, members[k])
+	}
+}
+
+// blameChars appends the spans of the given characters grouped by their
+// authors.
+func (b *blamer) blameChars(path string, chars []diffChar) {
+	var spans []*Attribution
+	var units [][]uint16
+	for _, char := range chars {
+		if n := len(spans); n > 0 {
+			last := spans[n-1]
+			if last.Author == char.createdAt.ActorIDHex() &&
+				last.Lamport == char.createdAt.Lamport() &&
+				last.Index+len(units[n-1]) == char.index {
+				units[n-1] = append(units[n-1], char.unit)
+				continue
+			}
+		}
+
+		span := newAttribution(path, char.createdAt)
+		span.Index = char.index
+		spans = append(spans, span)
+		units = append(units, []uint16{char.unit})
+	}
+
+	for i, span := range spans {
+		span.Content = string(utf16.Decode(units[i]))
+	}
+	b.attrs = append(b.attrs, spans...)
+}
+
+// newAttribution creates a new instance of Attribution of the given path
+// written at the given time.
+func newAttribution(path string, ticket *time.Ticket) *Attribution {
+	return &Attribution{
+		Path:    path,
+		Author:  ticket.ActorIDHex(),
+		Lamport: ticket.Lamport(),
+	}
+}
@@ -0,0 +1,589 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"slices"
+	"strconv"
+	"unicode/utf16"
+
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// DiffType represents the type of the difference between two roots.
+type DiffType string
+
+const (
+	// DiffAdd means that an element has been added to a container.
+	DiffAdd DiffType = "add"
+
+	// DiffRemove means that an element has been removed from a container.
+	DiffRemove DiffType = "remove"
+
+	// DiffChange means that an element has been replaced with another one, or
+	// the value of a counter has been changed.
+	DiffChange DiffType = "change"
+
+	// DiffMove means that an element of an array has been moved.
+	DiffMove DiffType = "move"
+
+	// DiffInsert means that contents have been inserted into a text or a tree.
+	DiffInsert DiffType = "insert"
+
+	// DiffDelete means that contents have been deleted from a text or a tree.
+	DiffDelete DiffType = "delete"
+
+	// DiffStyle means that the attributes of contents of a text or a tree
+	// have been changed.
+	DiffStyle DiffType = "style"
+)
+
+// Difference represents a difference between two roots. Path is the path of
+// the target element, e.g. "$.todos.1", and only the fields related to the
+// Type are set.
+type Difference struct {
+	Type DiffType `json:"type"`
+	Path string   `json:"path"`
+
+	// From and To are the JSON of the element before and after the difference.
+	// For DiffStyle, they are the JSON of the attributes.
+	From string `json:"from,omitempty"`
+	To   string `json:"to,omitempty"`
+
+	// FromIndex and ToIndex are the indexes of the element of an array before
+	// and after DiffMove.
+	FromIndex int `json:"fromIndex,omitempty"`
+	ToIndex   int `json:"toIndex,omitempty"`
+
+	// Index and Content are the index and the contents of DiffInsert,
+	// DiffDelete and DiffStyle on a text or a tree. The index of DiffDelete is
+	// based on the old root, and the others are based on the new root.
+	Index   int    `json:"index,omitempty"`
+	Content string `json:"content,omitempty"`
+
+	// Author is the hex encoded ID of the actor who made the difference. It is
+	// empty if the actor cannot be found in the new root.
+	Author string `json:"author,omitempty"`
+}
+
+// Diff returns the differences between the given two roots. Elements are
+// matched by their creation time, and the characters of texts and the nodes
+// of trees are matched by their IDs, so the roots should be the revisions of
+// the same document.
+func Diff(from, to *Root) ([]*Difference, error) {
+	d := &differ{from: from, to: to}
+	if err := d.diffObject("$", from.Object(), to.Object()); err != nil {
+		return nil, err
+	}
+
+	return d.diffs, nil
+}
+
+// differ collects the differences between two roots.
+type differ struct {
+	from  *Root
+	to    *Root
+	diffs []*Difference
+}
+
+// diffElement appends the differences between the given elements that have
+// the same creation time.
+func (d *differ) diffElement(path string, from, to Element) error {
+	switch from := from.(type) {
+	case *Object:
+		if to, ok := to.(*Object); ok {
+			return d.diffObject(path, from, to)
+		}
+	case *Array:
+		if to, ok := to.(*Array); ok {
+			return d.diffArray(path, from, to)
+		}
+	case *Counter:
+		if from.Marshal() != to.Marshal() {
+			d.diffs = append(d.diffs, &Difference{
+				Type: DiffChange,
+				Path: path,
+				From: from.Marshal(),
+				To:   to.Marshal(),
+			})
+		}
+	case *Text:
+		if to, ok := to.(*Text); ok {
+			d.diffText(path, from, to)
+		}
+	case *Tree:
+		if to, ok := to.(*Tree); ok {
+			d.diffTree(path, from, to)
+		}
+	}
+
+	return nil
+}
+
+// diffObject appends the differences between the members of the given
+// objects.
+func (d *differ) diffObject(path string, from, to *Object) error {
+	fromMembers := from.Members()
+	toMembers := to.Members()
+
+	var keys []string
+	for k := range fromMembers {
+		keys = append(keys, k)
+	}
+	for k := range toMembers {
+		if _, ok := fromMembers[k]; !ok {
+			keys = append(keys, k)
+		}
+	}
+	slices.Sort(keys)
+
+	for _, k := range keys {
+		subPath := path + "." + k
+		fromElem, inFrom := fromMembers[k]
+		toElem, inTo := toMembers[k]
+
+		switch {
+		case !inTo:
+			d.diffs = append(d.diffs, &Difference{
+				Type:   DiffRemove,
+				Path:   subPath,
+				From:   fromElem.Marshal(),
+				Author: d.removerOf(fromElem.CreatedAt()),
+			})
+		case !inFrom:
+			d.diffs = append(d.diffs, &Difference{
+				Type:   DiffAdd,
+				Path:   subPath,
+				To:     toElem.Marshal(),
+				Author: toElem.CreatedAt().ActorIDHex(),
+			})
+		case fromElem.CreatedAt().Compare(toElem.CreatedAt()) != 0:
+			d.diffs = append(d.diffs, &Difference{
+				Type:   DiffChange,
+				Path:   subPath,
+				From:   fromElem.Marshal(),
+				To:     toElem.Marshal(),
+				Author: toElem.CreatedAt().ActorIDHex(),
+			})
+		default:
+			if err := d.diffElement(subPath, fromElem, toElem); err != nil {
+				return err
+			}
+		}
+	}
+
+	return nil
+}
+
+// diffArray appends the differences between the elements of the given
+// arrays.
+func (d *differ) diffArray(path string, from, to *Array) error {
+	fromElems := from.Elements()
+	toElems := to.Elements()
+
+	fromIndexes := make(map[string]int, len(fromElems))
+	for idx, elem := range fromElems {
+		fromIndexes[elem.CreatedAt().Key()] = idx
+	}
+	toIndexes := make(map[string]int, len(toElems))
+	for idx, elem := range toElems {
+		toIndexes[elem.CreatedAt().Key()] = idx
+	}
+
+	for idx, elem := range fromElems {
+		if _, ok := toIndexes[elem.CreatedAt().Key()]; !ok {
+			d.diffs = append(d.diffs, &Difference{
+				Type:   DiffRemove,
+				Path:   path + "." + strconv.Itoa(idx),
+				From:   elem.Marshal(),
+				Author: d.removerOf(elem.CreatedAt()),
+			})
+		}
+	}
+
+	for idx, toElem := range toElems {
+		subPath := path + "." + strconv.Itoa(idx)
+		fromIdx, ok := fromIndexes[toElem.CreatedAt().Key()]
+		if !ok {
+			d.diffs = append(d.diffs, &Difference{
+				Type:   DiffAdd,
+				Path:   subPath,
+				To:     toElem.Marshal(),
+				Author: toElem.CreatedAt().ActorIDHex(),
+			})
+			continue
+		}
+
+		fromElem := fromElems[fromIdx]
+		if movedAt := toElem.MovedAt(); movedAt != nil && !equalTickets(fromElem.MovedAt(), movedAt) {
+			d.diffs = append(d.diffs, &Difference{
+				Type:      DiffMove,
+				Path:      path,
+				FromIndex: fromIdx,
+				ToIndex:   idx,
+				Author:    movedAt.ActorIDHex(),
+			})
+		}
+
+		if err := d.diffElement(subPath, fromElem, toElem); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// diffText appends the differences between the characters of the given
+// texts.
+func (d *differ) diffText(path string, from, to *Text) {
+	fromChars, _ := textChars(from)
+	toChars, toRemovedAt := textChars(to)
+	d.diffChars(path, fromChars, toChars, toRemovedAt, true)
+}
+
+// diffTree appends the differences between the nodes of the given trees.
+// Element nodes inserted or deleted are reported with their XML, and their
+// descendants are not reported separately.
+func (d *differ) diffTree(path string, from, to *Tree) {
+	fromTree := flattenTree(from)
+	toTree := flattenTree(to)
+
+	for _, elem := range fromTree.elements {
+		if toTree.has(elem.id) || !toTree.has(elem.parent) {
+			continue
+		}
+		d.diffs = append(d.diffs, &Difference{
+			Type:    DiffDelete,
+			Path:    path,
+			Index:   elem.index,
+			Content: ToXML(elem.node),
+			Author:  authorOf(toTree.removedAt[elem.id]),
+		})
+	}
+
+	for _, elem := range toTree.elements {
+		fromIdx, ok := fromTree.elementIndexes[elem.id]
+		if !ok {
+			if fromTree.has(elem.parent) {
+				d.diffs = append(d.diffs, &Difference{
+					Type:    DiffInsert,
+					Path:    path,
+					Index:   elem.index,
+					Content: ToXML(elem.node),
+					Author:  elem.node.ID().CreatedAt.ActorIDHex(),
+				})
+			}
+			continue
+		}
+
+		fromAttrs := marshalAttrs(fromTree.elements[fromIdx].node.Attrs)
+		toAttrs := marshalAttrs(elem.node.Attrs)
+		if fromAttrs != toAttrs {
+			d.diffs = append(d.diffs, &Difference{
+				Type:  DiffStyle,
+				Path:  path,
+				Index: elem.index,
+				From:  fromAttrs,
+				To:    toAttrs,
+			})
+		}
+	}
+
+	for i, char := range fromTree.chars {
+		fromTree.chars[i].skipped = !toTree.has(char.parent)
+	}
+	for i, char := range toTree.chars {
+		toTree.chars[i].skipped = !fromTree.has(char.parent)
+	}
+	d.diffChars(path, fromTree.chars, toTree.chars, toTree.removedAt, false)
+}
+
+// diffChars appends the spans of the characters inserted, deleted and styled
+// between the given characters. The characters in the elements inserted or
+// deleted are skipped because they are reported with the elements.
+func (d *differ) diffChars(
+	path string,
+	fromChars, toChars []diffChar,
+	toRemovedAt map[string]*time.Ticket,
+	withStyle bool,
+) {
+	fromByID := make(map[string]diffChar, len(fromChars))
+	for _, char := range fromChars {
+		fromByID[char.id] = char
+	}
+	toByID := make(map[string]diffChar, len(toChars))
+	for _, char := range toChars {
+		toByID[char.id] = char
+	}
+
+	deleted := &spanBuilder{}
+	for _, char := range fromChars {
+		if _, ok := toByID[char.id]; ok || char.skipped {
+			continue
+		}
+		deleted.add(DiffDelete, path, char.index, char.unit, authorOf(toRemovedAt[char.id]), "")
+	}
+
+	inserted := &spanBuilder{}
+	styled := &spanBuilder{}
+	for _, char := range toChars {
+		fromChar, ok := fromByID[char.id]
+		if !ok {
+			if !char.skipped {
+				inserted.add(DiffInsert, path, char.index, char.unit, char.createdAt.ActorIDHex(), "")
+			}
+			continue
+		}
+
+		if withStyle && fromChar.attrs != char.attrs {
+			styled.add(DiffStyle, path, char.index, char.unit, "", char.attrs)
+		}
+	}
+
+	d.diffs = append(d.diffs, deleted.build()...)
+	d.diffs = append(d.diffs, inserted.build()...)
+	d.diffs = append(d.diffs, styled.build()...)
+}
+
+// removerOf returns the author who removed the element of the given creation
+// time in the new root.
+func (d *differ) removerOf(createdAt *time.Ticket) string {
+	elem := d.to.FindByCreatedAt(createdAt)
+	if elem == nil {
+		return ""
+	}
+
+	return authorOf(elem.RemovedAt())
+}
+
+// diffChar is a character of a text or a tree. The character is a UTF-16 code
+// unit, and its ID is derived from the ID of the node that contains it.
+type diffChar struct {
+	id        string
+	index     int
+	unit      uint16
+	attrs     string
+	createdAt *time.Ticket
+
+	// parent is the ID of the element node that contains the character in a
+	// tree, and skipped is whether the element node is inserted or deleted as
+	// a whole.
+	parent  string
+	skipped bool
+}
+
+// textChars returns the live characters of the given text and the removal
+// time of the removed characters by their IDs.
+func textChars(text *Text) ([]diffChar, map[string]*time.Ticket) {
+	var chars []diffChar
+	removedAt := make(map[string]*time.Ticket)
+
+	idx := 0
+	for _, node := range text.Nodes() {
+		units := utf16.Encode([]rune(node.Value().Value()))
+		for i, unit := range units {
+			id := charID(node.ID().createdAt, node.ID().offset+i)
+			if node.RemovedAt() != nil {
+				removedAt[id] = node.RemovedAt()
+				continue
+			}
+
+			chars = append(chars, diffChar{
+				id:        id,
+				index:     idx,
+				unit:      unit,
+				attrs:     marshalAttrs(node.Value().Attrs()),
+				createdAt: node.ID().createdAt,
+			})
+			idx++
+		}
+	}
+
+	return chars, removedAt
+}
+
+// flatTree is the live nodes of a tree flattened in the order of the index.
+type flatTree struct {
+	elements       []treeElement
+	elementIndexes map[string]int
+	chars          []diffChar
+
+	// removedAt is the removal time of the removed nodes and characters.
+	removedAt map[string]*time.Ticket
+}
+
+// treeElement is an element node of a flattened tree.
+type treeElement struct {
+	id     string
+	index  int
+	node   *TreeNode
+	parent string
+}
+
+// flattenTree flattens the live nodes of the given tree. The index of an
+// element node is the index before its opening tag.
+func flattenTree(tree *Tree) *flatTree {
+	flat := &flatTree{
+		elementIndexes: make(map[string]int),
+		removedAt:      make(map[string]*time.Ticket),
+	}
+
+	idx := 0
+	var traverse func(node *TreeNode, parent string)
+	traverse = func(node *TreeNode, parent string) {
+		for _, child := range node.Index.Children(true) {
+			childNode := child.Value
+			if childNode.IsRemoved() {
+				flat.collectRemovedAt(childNode, childNode.RemovedAt())
+				continue
+			}
+
+			if childNode.IsText() {
+				units := utf16.Encode([]rune(childNode.Value))
+				for i, unit := range units {
+					flat.chars = append(flat.chars, diffChar{
+						id:        charID(childNode.ID().CreatedAt, childNode.ID().Offset+i),
+						index:     idx,
+						unit:      unit,
+						createdAt: childNode.ID().CreatedAt,
+						parent:    parent,
+					})
+					idx++
+				}
+				continue
+			}
+
+			id := charID(childNode.ID().CreatedAt, childNode.ID().Offset)
+			flat.elementIndexes[id] = len(flat.elements)
+			flat.elements = append(flat.elements, treeElement{
+				id:     id,
+				index:  idx,
+				node:   childNode,
+				parent: parent,
+			})
+			idx++
+			traverse(childNode, id)
+			idx++
+		}
+	}
+	traverse(tree.Root(), "")
+
+	return flat
+}
+
+// collectRemovedAt collects the removal time of the given removed node and
+// its descendants. The descendants without their own removal time are
+// considered to be removed with the given node.
+func (f *flatTree) collectRemovedAt(node *TreeNode, removedAt *time.Ticket) {
+	if node.RemovedAt() != nil {
+		removedAt = node.RemovedAt()
+	}
+
+	if node.IsText() {
+		for i := range utf16.Encode([]rune(node.Value)) {
+			f.removedAt[charID(node.ID().CreatedAt, node.ID().Offset+i)] = removedAt
+		}
+		return
+	}
+
+	f.removedAt[charID(node.ID().CreatedAt, node.ID().Offset)] = removedAt
+	for _, child := range node.Index.Children(true) {
+		f.collectRemovedAt(child.Value, removedAt)
+	}
+}
+
+// has returns whether this tree has the element node of the given ID. The
+// root node, whose ID is empty, always exists.
+func (f *flatTree) has(id string) bool {
+	if id == "" {
+		return true
+	}
+
+	_, ok := f.elementIndexes[id]
+	return ok
+}
+
+// spanBuilder groups the consecutive characters of the same author and the
+// same attributes into a span.
+type spanBuilder struct {
+	diffs []*Difference
+	units [][]uint16
+}
+
+// add adds the given character to the last span if it is next to the span.
+// Otherwise, it starts a new span.
+func (b *spanBuilder) add(diffType DiffType, path string, idx int, unit uint16, author, attrs string) {
+	if n := len(b.diffs); n > 0 {
+		last := b.diffs[n-1]
+		if last.Author == author && last.To == attrs && last.Index+len(b.units[n-1]) == idx {
+			b.units[n-1] = append(b.units[n-1], unit)
+			return
+		}
+	}
+
+	b.diffs = append(b.diffs, &Difference{
+		Type:   diffType,
+		Path:   path,
+		Index:  idx,
+		To:     attrs,
+		Author: author,
+	})
+	b.units = append(b.units, []uint16{unit})
+}
+
+// build returns the spans with their contents.
+func (b *spanBuilder) build() []*Difference {
+	for i, diff := range b.diffs {
+		diff.Content = string(utf16.Decode(b.units[i]))
+	}
+
+	return b.diffs
+}
+
+// charID returns the ID of the character at the given offset of the node
+// created at the given time.
+func charID(createdAt *time.Ticket, offset int) string {
+	return createdAt.Key() + ":" + strconv.Itoa(offset)
+}
+
+// marshalAttrs returns the JSON of the given attributes.
+func marshalAttrs(attrs *RHT) string {
+	if attrs == nil {
+		return "{}"
+	}
+
+	return attrs.Marshal()
+}
+
+// authorOf returns the hex encoded actor ID of the given ticket.
+func authorOf(ticket *time.Ticket) string {
+	if ticket == nil {
+		return ""
+	}
+
+	return ticket.ActorIDHex()
+}
+
+// equalTickets returns whether the given tickets are equal. Nil tickets are
+// equal to each other.
+func equalTickets(a, b *time.Ticket) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+
+	return a.Compare(b) == 0
+}
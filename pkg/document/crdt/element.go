@@ -37,6 +37,10 @@ type Container interface {
 
 	// DeleteByCreatedAt removes the given element from this container.
 	DeleteByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticket) (Element, error)
+
+	// SubPathOf returns the sub path of the given child element in this
+	// container. It is the key for Object and the index for Array.
+	SubPathOf(createdAt *time.Ticket) (string, error)
 }
 
 // Element represents JSON element.
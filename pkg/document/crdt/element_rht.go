@@ -142,6 +142,16 @@ func (rht *ElementRHT) DeleteByCreatedAt(createdAt *time.Ticket, deletedAt *time
 	return node.elem, nil
 }
 
+// KeyOf returns the key of the element of the given creation time.
+func (rht *ElementRHT) KeyOf(createdAt *time.Ticket) (string, error) {
+	node, ok := rht.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok {
+		return "", fmt.Errorf("KeyOf %s: %w", createdAt.Key(), ErrChildNotFound)
+	}
+
+	return node.key, nil
+}
+
 // Elements returns a map of elements because the map easy to use for loop.
 // TODO: If we encounter performance issues, we need to replace this with other solution.
 func (rht *ElementRHT) Elements() map[string]Element {
@@ -72,6 +72,11 @@ func (o *Object) DeleteByCreatedAt(createdAt *time.Ticket, deletedAt *time.Ticke
 	return o.memberNodes.DeleteByCreatedAt(createdAt, deletedAt)
 }
 
+// SubPathOf returns the key of the given child element as a sub path.
+func (o *Object) SubPathOf(createdAt *time.Ticket) (string, error) {
+	return o.memberNodes.KeyOf(createdAt)
+}
+
 // Delete deletes the element of the given key.
 func (o *Object) Delete(k string, deletedAt *time.Ticket) Element {
 	return o.memberNodes.Delete(k, deletedAt)
@@ -283,6 +283,17 @@ func (a *RGATreeList) FindPrevCreatedAt(createdAt *time.Ticket) (*time.Ticket, e
 	return node.CreatedAt(), nil
 }
 
+// IndexOf returns the index of the element of the given creation time. If the
+// element is removed, it returns the index where the element was.
+func (a *RGATreeList) IndexOf(createdAt *time.Ticket) (int, error) {
+	node, ok := a.nodeMapByCreatedAt[createdAt.Key()]
+	if !ok {
+		return 0, fmt.Errorf("IndexOf %s: %w", createdAt.Key(), ErrChildNotFound)
+	}
+
+	return a.nodeMapByIndex.IndexOf(node.indexNode), nil
+}
+
 // purge physically purge child element.
 func (a *RGATreeList) purge(elem Element) error {
 	node, ok := a.nodeMapByCreatedAt[elem.CreatedAt().Key()]
@@ -366,6 +377,7 @@ func (a *RGATreeList) Set(
 
 		node.elem = element
 		node.elem.SetMovedAt(executedAt)
+		a.nodeMapByCreatedAt[element.CreatedAt().Key()] = node
 	}
 	return removed, nil
 }
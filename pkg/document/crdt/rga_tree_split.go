@@ -111,6 +111,35 @@ type RGATreeSplitNodePos struct {
 	relativeOffset int
 }
 
+// RGATreeSplitNodeIDStruct is a structure that represents RGATreeSplitNodeID
+// in a serializable form.
+type RGATreeSplitNodeIDStruct struct {
+	CreatedAt time.TicketStruct `json:"createdAt"`
+	Offset    int               `json:"offset"`
+}
+
+// RGATreeSplitNodePosStruct is a structure that represents
+// RGATreeSplitNodePos in a serializable form. It can be stored in the
+// presence to share the position that is stable against remote changes.
+type RGATreeSplitNodePosStruct struct {
+	ID             RGATreeSplitNodeIDStruct `json:"id"`
+	RelativeOffset int                      `json:"relativeOffset"`
+}
+
+// RGATreeSplitNodePosFromStruct creates an instance of RGATreeSplitNodePos
+// from the given structure.
+func RGATreeSplitNodePosFromStruct(s RGATreeSplitNodePosStruct) (*RGATreeSplitNodePos, error) {
+	createdAt, err := time.TicketFromStruct(s.ID.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewRGATreeSplitNodePos(
+		NewRGATreeSplitNodeID(createdAt, s.ID.Offset),
+		s.RelativeOffset,
+	), nil
+}
+
 // NewRGATreeSplitNodePos creates a new instance of RGATreeSplitNodePos.
 func NewRGATreeSplitNodePos(id *RGATreeSplitNodeID, offset int) *RGATreeSplitNodePos {
 	return &RGATreeSplitNodePos{id, offset}
@@ -126,6 +155,17 @@ func (pos *RGATreeSplitNodePos) ToTestString() string {
 	return fmt.Sprintf("%s:%d", pos.id.ToTestString(), pos.relativeOffset)
 }
 
+// ToStruct returns the structure of this position in a serializable form.
+func (pos *RGATreeSplitNodePos) ToStruct() RGATreeSplitNodePosStruct {
+	return RGATreeSplitNodePosStruct{
+		ID: RGATreeSplitNodeIDStruct{
+			CreatedAt: pos.id.createdAt.ToStruct(),
+			Offset:    pos.id.offset,
+		},
+		RelativeOffset: pos.relativeOffset,
+	}
+}
+
 // ID returns the ID of this RGATreeSplitNodePos.
 func (pos *RGATreeSplitNodePos) ID() *RGATreeSplitNodeID {
 	return pos.id
@@ -349,6 +389,26 @@ func (s *RGATreeSplit[V]) findNodePos(index int) (*RGATreeSplitNodePos, error) {
 	}, nil
 }
 
+// posToIndex returns the index of the given position. If the node of the
+// position is removed, it returns the index right after the nearest live node.
+func (s *RGATreeSplit[V]) posToIndex(pos *RGATreeSplitNodePos) (int, error) {
+	absoluteID := pos.getAbsoluteID()
+	node, err := s.findFloorNodePreferToLeft(absoluteID)
+	if err != nil {
+		return 0, err
+	}
+
+	if node.removedAt != nil {
+		for node.prev != nil && node.removedAt != nil {
+			node = node.prev
+		}
+		return s.treeByIndex.IndexOf(node.indexNode) + node.Len(), nil
+	}
+
+	offset := absoluteID.offset - node.id.offset
+	return s.treeByIndex.IndexOf(node.indexNode) + offset, nil
+}
+
 func (s *RGATreeSplit[V]) findNodeWithSplit(
 	pos *RGATreeSplitNodePos,
 	updatedAt *time.Ticket,
@@ -20,9 +20,17 @@
 package crdt
 
 import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
 
+// ErrInvalidPath is returned when the given path is not a valid JSON path.
+var ErrInvalidPath = errors.New("invalid path")
+
 // ElementPair represents pair that has a parent element and child element.
 type ElementPair struct {
 	parent Container
@@ -37,7 +45,7 @@ type ElementPair struct {
 // a particular element.
 type Root struct {
 	object           *Object
-	elementMap       map[string]Element
+	elementMap       map[string]ElementPair
 	gcElementPairMap map[string]ElementPair
 	gcNodePairMap    map[string]GCPair
 }
@@ -45,13 +53,13 @@ type Root struct {
 // NewRoot creates a new instance of Root.
 func NewRoot(root *Object) *Root {
 	r := &Root{
-		elementMap:       make(map[string]Element),
+		elementMap:       make(map[string]ElementPair),
 		gcElementPairMap: make(map[string]ElementPair),
 		gcNodePairMap:    make(map[string]GCPair),
 	}
 
 	r.object = root
-	r.RegisterElement(root)
+	r.RegisterElement(nil, root)
 
 	root.Descendants(func(elem Element, parent Container) bool {
 		if elem.RemovedAt() != nil {
@@ -81,18 +89,175 @@ func (r *Root) Object() *Object {
 
 // FindByCreatedAt returns the element of given creation time.
 func (r *Root) FindByCreatedAt(createdAt *time.Ticket) Element {
-	return r.elementMap[createdAt.Key()]
+	pair, ok := r.elementMap[createdAt.Key()]
+	if !ok {
+		return nil
+	}
+	return pair.elem
 }
 
-// RegisterElement registers the given element to hash table.
-func (r *Root) RegisterElement(element Element) {
-	r.elementMap[element.CreatedAt().Key()] = element
+// FindByPath returns the element of the given path, e.g. "$.todos.3".
+func (r *Root) FindByPath(path string) (Element, error) {
+	subPaths, err := SplitPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	var elem Element = r.object
+	for _, subPath := range subPaths[1:] {
+		switch e := elem.(type) {
+		case *Object:
+			elem = e.Get(subPath)
+		case *Array:
+			idx, err := strconv.Atoi(subPath)
+			if err != nil {
+				return nil, fmt.Errorf("FindByPath %s: %w", path, ErrInvalidPath)
+			}
+			if idx < 0 || idx >= e.Len() {
+				return nil, fmt.Errorf("FindByPath %s: %w", path, ErrChildNotFound)
+			}
+			if elem, err = e.Get(idx); err != nil {
+				return nil, err
+			}
+		default:
+			elem = nil
+		}
+
+		if elem == nil {
+			return nil, fmt.Errorf("FindByPath %s: %w", path, ErrChildNotFound)
+		}
+	}
+
+	return elem, nil
+}
+
+// SplitPath splits the given path into sub paths. The path should start with
+// "$" which represents the root object, and the indexes of arrays can be
+// written in brackets, e.g. "$.todos[3].title". The keys that contain
+// special characters such as "." should be quoted in brackets, e.g.
+// `$["a.b"]`, as JoinPath writes them.
+func SplitPath(path string) ([]string, error) {
+	rest, ok := strings.CutPrefix(path, "$")
+	if !ok {
+		return nil, fmt.Errorf("SplitPath %s: %w", path, ErrInvalidPath)
+	}
+
+	subPaths := []string{"$"}
+	for rest != "" {
+		var subPath string
+		switch {
+		case rest[0] == '.':
+			end := strings.IndexAny(rest[1:], ".[")
+			if end < 0 {
+				end = len(rest) - 1
+			}
+			subPath, rest = rest[1:end+1], rest[end+1:]
+			if subPath == "" {
+				return nil, fmt.Errorf("SplitPath %s: %w", path, ErrInvalidPath)
+			}
+		case strings.HasPrefix(rest, `["`):
+			quoted, err := strconv.QuotedPrefix(rest[1:])
+			if err != nil {
+				return nil, fmt.Errorf("SplitPath %s: %w", path, ErrInvalidPath)
+			}
+			if subPath, err = strconv.Unquote(quoted); err != nil {
+				return nil, fmt.Errorf("SplitPath %s: %w", path, ErrInvalidPath)
+			}
+			if rest, ok = strings.CutPrefix(rest[1+len(quoted):], "]"); !ok {
+				return nil, fmt.Errorf("SplitPath %s: %w", path, ErrInvalidPath)
+			}
+		case rest[0] == '[':
+			end := strings.IndexByte(rest, ']')
+			if end < 0 {
+				return nil, fmt.Errorf("SplitPath %s: %w", path, ErrInvalidPath)
+			}
+			subPath, rest = rest[1:end], rest[end+1:]
+			if subPath == "" {
+				return nil, fmt.Errorf("SplitPath %s: %w", path, ErrInvalidPath)
+			}
+		default:
+			return nil, fmt.Errorf("SplitPath %s: %w", path, ErrInvalidPath)
+		}
+
+		subPaths = append(subPaths, subPath)
+	}
+
+	return subPaths, nil
+}
+
+// JoinPath joins the given sub paths into a path that SplitPath splits back
+// into the same sub paths, e.g. "$.todos.3". The sub paths that cannot be
+// written after "." are quoted in brackets, e.g. `$["a.b"]`.
+func JoinPath(subPaths []string) string {
+	var builder strings.Builder
+	for i, subPath := range subPaths {
+		switch {
+		case i == 0:
+			builder.WriteString(subPath)
+		case subPath == "" || strings.ContainsAny(subPath, `.[]"`):
+			builder.WriteString("[")
+			builder.WriteString(strconv.Quote(subPath))
+			builder.WriteString("]")
+		default:
+			builder.WriteString(".")
+			builder.WriteString(subPath)
+		}
+	}
+	return builder.String()
+}
+
+// CreateSubPaths returns the sub paths from the root to the element of the
+// given creation time. The first sub path is always "$".
+func (r *Root) CreateSubPaths(createdAt *time.Ticket) ([]string, error) {
+	pair, ok := r.elementMap[createdAt.Key()]
+	if !ok {
+		return nil, fmt.Errorf("CreateSubPaths %s: %w", createdAt.Key(), ErrChildNotFound)
+	}
+
+	var subPaths []string
+	for pair.parent != nil {
+		subPath, err := pair.parent.SubPathOf(pair.elem.CreatedAt())
+		if err != nil {
+			return nil, err
+		}
+		subPaths = append([]string{subPath}, subPaths...)
+
+		pair, ok = r.elementMap[pair.parent.CreatedAt().Key()]
+		if !ok {
+			return nil, fmt.Errorf("CreateSubPaths %s: %w", createdAt.Key(), ErrChildNotFound)
+		}
+	}
+
+	return append([]string{"$"}, subPaths...), nil
+}
+
+// CreatePath returns the path from the root to the element of the given
+// creation time, e.g. "$.todos.3".
+func (r *Root) CreatePath(createdAt *time.Ticket) (string, error) {
+	subPaths, err := r.CreateSubPaths(createdAt)
+	if err != nil {
+		return "", err
+	}
+
+	return JoinPath(subPaths), nil
+}
+
+// RegisterElement registers the given element and its descendants to hash
+// table. The parent is nil only for the root object.
+func (r *Root) RegisterElement(parent Container, element Element) {
+	r.elementMap[element.CreatedAt().Key()] = ElementPair{
+		parent,
+		element,
+	}
 
 	switch element := element.(type) {
 	case Container:
 		{
 			element.Descendants(func(elem Element, parent Container) bool {
-				r.elementMap[elem.CreatedAt().Key()] = elem
+				r.elementMap[elem.CreatedAt().Key()] = ElementPair{
+					parent,
+					elem,
+				}
 				return false
 			})
 		}
@@ -254,4 +254,91 @@ func TestRoot(t *testing.T) {
 		assert.Equal(t, 1, n)
 		assert.Equal(t, 0, root.GarbageLen())
 	})
+
+	t.Run("create path test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		todos := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+		root.Object().Set("todos", todos)
+		root.RegisterElement(root.Object(), todos)
+
+		var last crdt.Element
+		for i := 0; i < 3; i++ {
+			obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+			assert.NoError(t, todos.Add(obj))
+			root.RegisterElement(todos, obj)
+			last = obj
+		}
+
+		path, err := root.CreatePath(root.Object().CreatedAt())
+		assert.NoError(t, err)
+		assert.Equal(t, "$", path)
+
+		path, err = root.CreatePath(last.CreatedAt())
+		assert.NoError(t, err)
+		assert.Equal(t, "$.todos.2", path)
+
+		_, err = root.CreatePath(ctx.IssueTimeTicket())
+		assert.ErrorIs(t, err, crdt.ErrChildNotFound)
+	})
+
+	t.Run("split and join path test", func(t *testing.T) {
+		subPaths, err := crdt.SplitPath("$.todos[3].title")
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"$", "todos", "3", "title"}, subPaths)
+		assert.Equal(t, "$.todos.3.title", crdt.JoinPath(subPaths))
+
+		subPaths, err = crdt.SplitPath(`$["a.b"].c["[0]"][""]`)
+		assert.NoError(t, err)
+		assert.Equal(t, []string{"$", "a.b", "c", "[0]", ""}, subPaths)
+
+		for _, subPaths := range [][]string{
+			{"$"},
+			{"$", "a.b", "c"},
+			{"$", `say "hi"`, "[0]", "", "a]b"},
+		} {
+			split, err := crdt.SplitPath(crdt.JoinPath(subPaths))
+			assert.NoError(t, err)
+			assert.Equal(t, subPaths, split)
+		}
+
+		for _, path := range []string{"", "todos", "$.", "$..a", "$[]", "$[0", `$["a]`, `$["a"`, "$a"} {
+			_, err := crdt.SplitPath(path)
+			assert.ErrorIs(t, err, crdt.ErrInvalidPath, path)
+		}
+	})
+
+	t.Run("find by path test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+
+		todos := crdt.NewArray(crdt.NewRGATreeList(), ctx.IssueTimeTicket())
+		root.Object().Set("todos", todos)
+		root.RegisterElement(root.Object(), todos)
+
+		obj := crdt.NewObject(crdt.NewElementRHT(), ctx.IssueTimeTicket())
+		assert.NoError(t, todos.Add(obj))
+		root.RegisterElement(todos, obj)
+
+		elem, err := root.FindByPath("$")
+		assert.NoError(t, err)
+		assert.Equal(t, root.Object(), elem)
+
+		elem, err = root.FindByPath("$.todos.0")
+		assert.NoError(t, err)
+		assert.Equal(t, obj, elem)
+		elem, err = root.FindByPath("$.todos[0]")
+		assert.NoError(t, err)
+		assert.Equal(t, obj, elem)
+
+		_, err = root.FindByPath("$.todos.1")
+		assert.ErrorIs(t, err, crdt.ErrChildNotFound)
+		_, err = root.FindByPath("$.todos.first")
+		assert.ErrorIs(t, err, crdt.ErrInvalidPath)
+		_, err = root.FindByPath("todos")
+		assert.ErrorIs(t, err, crdt.ErrInvalidPath)
+		_, err = root.FindByPath("$.todos[0")
+		assert.ErrorIs(t, err, crdt.ErrInvalidPath)
+	})
 }
@@ -265,6 +265,38 @@ func (t *Text) CreateRange(from, to int) (*RGATreeSplitNodePos, *RGATreeSplitNod
 	return t.rgaTreeSplit.createRange(from, to)
 }
 
+// FindIndexesFromRange returns the integer offsets of the given range.
+func (t *Text) FindIndexesFromRange(from, to *RGATreeSplitNodePos) (int, int, error) {
+	fromIdx, err := t.rgaTreeSplit.posToIndex(from)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	toIdx, err := t.rgaTreeSplit.posToIndex(to)
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return fromIdx, toIdx, nil
+}
+
+// IndexToPos returns the position of the given index. Unlike the index, the
+// position keeps pointing the same place even after remote changes.
+func (t *Text) IndexToPos(idx int) (*RGATreeSplitNodePos, error) {
+	return t.rgaTreeSplit.findNodePos(idx)
+}
+
+// PosToIndex returns the index of the given position. If the text of the
+// position is removed, it returns the index where the text was.
+func (t *Text) PosToIndex(pos *RGATreeSplitNodePos) (int, error) {
+	return t.rgaTreeSplit.posToIndex(pos)
+}
+
+// Len returns the length of this Text.
+func (t *Text) Len() int {
+	return t.rgaTreeSplit.treeByIndex.Len()
+}
+
 // Edit edits the given range with the given content and attributes.
 func (t *Text) Edit(
 	from,
@@ -299,6 +331,79 @@ func (t *Text) Style(
 	executedAt *time.Ticket,
 	versionVector time.VersionVector,
 ) (map[string]*time.Ticket, []GCPair, error) {
+	createdAtMapByActor, toBeStyled, err := t.findNodesToStyle(
+		from,
+		to,
+		maxCreatedAtMapByActor,
+		executedAt,
+		versionVector,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pairs []GCPair
+	for _, node := range toBeStyled {
+		val := node.value
+		for key, value := range attributes {
+			if rhtNode := val.attrs.Set(key, value, executedAt); rhtNode != nil {
+				pairs = append(pairs, GCPair{
+					Parent: node.Value(),
+					Child:  rhtNode,
+				})
+			}
+		}
+	}
+
+	return createdAtMapByActor, pairs, nil
+}
+
+// RemoveStyle removes the given attributes of the given range.
+func (t *Text) RemoveStyle(
+	from,
+	to *RGATreeSplitNodePos,
+	maxCreatedAtMapByActor map[string]*time.Ticket,
+	attributesToRemove []string,
+	executedAt *time.Ticket,
+	versionVector time.VersionVector,
+) (map[string]*time.Ticket, []GCPair, error) {
+	createdAtMapByActor, toBeStyled, err := t.findNodesToStyle(
+		from,
+		to,
+		maxCreatedAtMapByActor,
+		executedAt,
+		versionVector,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	var pairs []GCPair
+	for _, node := range toBeStyled {
+		val := node.value
+		for _, key := range attributesToRemove {
+			for _, rhtNode := range val.attrs.Remove(key, executedAt) {
+				pairs = append(pairs, GCPair{
+					Parent: node.Value(),
+					Child:  rhtNode,
+				})
+			}
+		}
+	}
+
+	return createdAtMapByActor, pairs, nil
+}
+
+// findNodesToStyle splits nodes with the given range and returns the nodes
+// that can be styled by the operation executed at the given time, along with
+// the latest creation time by actor of them.
+func (t *Text) findNodesToStyle(
+	from,
+	to *RGATreeSplitNodePos,
+	maxCreatedAtMapByActor map[string]*time.Ticket,
+	executedAt *time.Ticket,
+	versionVector time.VersionVector,
+) (map[string]*time.Ticket, []*RGATreeSplitNode[*TextValue], error) {
 	// 01. Split nodes with from and to
 	_, toRight, err := t.rgaTreeSplit.findNodeWithSplit(to, executedAt)
 	if err != nil {
@@ -356,20 +461,7 @@ func (t *Text) Style(
 		}
 	}
 
-	var pairs []GCPair
-	for _, node := range toBeStyled {
-		val := node.value
-		for key, value := range attributes {
-			if rhtNode := val.attrs.Set(key, value, executedAt); rhtNode != nil {
-				pairs = append(pairs, GCPair{
-					Parent: node.Value(),
-					Child:  rhtNode,
-				})
-			}
-		}
-	}
-
-	return createdAtMapByActor, pairs, nil
+	return createdAtMapByActor, toBeStyled, nil
 }
 
 // Nodes returns the internal nodes of this Text.
@@ -88,4 +88,85 @@ func TestText(t *testing.T) {
 			text.Marshal(),
 		)
 	})
+
+	t.Run("position test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos, _ := text.CreateRange(0, 0)
+		_, _, _, err := text.Edit(fromPos, toPos, nil, "Hello World", nil, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+
+		pos, err := text.IndexToPos(8)
+		assert.NoError(t, err)
+		clone, err := crdt.RGATreeSplitNodePosFromStruct(pos.ToStruct())
+		assert.NoError(t, err)
+		assert.True(t, pos.Equal(clone))
+
+		// 01. The position is shifted by the edit in front of it.
+		fromPos, toPos, _ = text.CreateRange(0, 5)
+		_, _, _, err = text.Edit(fromPos, toPos, nil, "Hi", nil, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+		idx, err := text.PosToIndex(clone)
+		assert.NoError(t, err)
+		assert.Equal(t, 5, idx)
+
+		// 02. The position is moved to the start of the removed text.
+		fromPos, toPos, _ = text.CreateRange(3, 7)
+		_, _, _, err = text.Edit(fromPos, toPos, nil, "", nil, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "Hi d", text.String())
+		idx, err = text.PosToIndex(clone)
+		assert.NoError(t, err)
+		assert.Equal(t, 3, idx)
+	})
+
+	t.Run("remove style test", func(t *testing.T) {
+		root := helper.TestRoot()
+		ctx := helper.TextChangeContext(root)
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ctx.IssueTimeTicket())
+
+		fromPos, toPos, _ := text.CreateRange(0, 0)
+		_, _, _, err := text.Edit(fromPos, toPos, nil, "Hello", nil, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+
+		fromPos, toPos, _ = text.CreateRange(0, 5)
+		_, _, err = text.Style(fromPos, toPos, nil, map[string]string{"b": "1", "i": "1"}, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, `[{"attrs":{"b":"1","i":"1"},"val":"Hello"}]`, text.Marshal())
+
+		// 01. Remove the attribute from the part of the text.
+		fromPos, toPos, _ = text.CreateRange(1, 3)
+		_, pairs, err := text.RemoveStyle(fromPos, toPos, nil, []string{"b"}, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+		assert.Len(t, pairs, 1)
+		assert.Equal(
+			t,
+			`[{"attrs":{"b":"1","i":"1"},"val":"H"},{"attrs":{"i":"1"},"val":"el"},{"attrs":{"b":"1","i":"1"},"val":"lo"}]`,
+			text.Marshal(),
+		)
+
+		// 02. Removing the absent attribute leaves a tombstone for concurrency.
+		fromPos, toPos, _ = text.CreateRange(0, 1)
+		_, pairs, err = text.RemoveStyle(fromPos, toPos, nil, []string{"u"}, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+		assert.Len(t, pairs, 1)
+		assert.Equal(
+			t,
+			`[{"attrs":{"b":"1","i":"1"},"val":"H"},{"attrs":{"i":"1"},"val":"el"},{"attrs":{"b":"1","i":"1"},"val":"lo"}]`,
+			text.Marshal(),
+		)
+
+		// 03. The attribute set after the removal is applied again.
+		fromPos, toPos, _ = text.CreateRange(1, 3)
+		_, pairs, err = text.Style(fromPos, toPos, nil, map[string]string{"b": "2"}, ctx.IssueTimeTicket(), nil)
+		assert.NoError(t, err)
+		assert.Len(t, pairs, 1)
+		assert.Equal(
+			t,
+			`[{"attrs":{"b":"1","i":"1"},"val":"H"},{"attrs":{"b":"2","i":"1"},"val":"el"},{"attrs":{"b":"1","i":"1"},"val":"lo"}]`,
+			text.Marshal(),
+		)
+	})
 }
@@ -63,6 +63,37 @@ func NewTreePos(parentID *TreeNodeID, leftSiblingID *TreeNodeID) *TreePos {
 	}
 }
 
+// TreePosStruct is a structure that represents TreePos in a serializable
+// form. It can be stored in the presence to share the position that is
+// stable against remote changes.
+type TreePosStruct struct {
+	ParentID      TreeNodeIDStruct `json:"parentID"`
+	LeftSiblingID TreeNodeIDStruct `json:"leftSiblingID"`
+}
+
+// TreePosFromStruct creates an instance of TreePos from the given structure.
+func TreePosFromStruct(s TreePosStruct) (*TreePos, error) {
+	parentID, err := TreeNodeIDFromStruct(s.ParentID)
+	if err != nil {
+		return nil, err
+	}
+
+	leftSiblingID, err := TreeNodeIDFromStruct(s.LeftSiblingID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTreePos(parentID, leftSiblingID), nil
+}
+
+// ToStruct returns the structure of this position in a serializable form.
+func (t *TreePos) ToStruct() TreePosStruct {
+	return TreePosStruct{
+		ParentID:      t.ParentID.ToStruct(),
+		LeftSiblingID: t.LeftSiblingID.ToStruct(),
+	}
+}
+
 // Equals compares the given two CRDTTreePos.
 func (t *TreePos) Equals(other *TreePos) bool {
 	return t.ParentID.CreatedAt.Compare(other.ParentID.CreatedAt) == 0 &&
@@ -105,6 +136,32 @@ func NewTreeNode(id *TreeNodeID, nodeType string, attributes *RHT, value ...stri
 	return node
 }
 
+// TreeNodeIDStruct is a structure that represents TreeNodeID in a
+// serializable form.
+type TreeNodeIDStruct struct {
+	CreatedAt time.TicketStruct `json:"createdAt"`
+	Offset    int               `json:"offset"`
+}
+
+// TreeNodeIDFromStruct creates an instance of TreeNodeID from the given
+// structure.
+func TreeNodeIDFromStruct(s TreeNodeIDStruct) (*TreeNodeID, error) {
+	createdAt, err := time.TicketFromStruct(s.CreatedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTreeNodeID(createdAt, s.Offset), nil
+}
+
+// ToStruct returns the structure of this ID in a serializable form.
+func (t *TreeNodeID) ToStruct() TreeNodeIDStruct {
+	return TreeNodeIDStruct{
+		CreatedAt: t.CreatedAt.ToStruct(),
+		Offset:    t.Offset,
+	}
+}
+
 // toIDString returns a string that can be used as an ID for this TreeNodeID.
 func (t *TreeNodeID) toIDString() string {
 	return t.CreatedAt.ToTestString() + ":" + strconv.Itoa(t.Offset)
@@ -140,6 +197,15 @@ type TreeNode struct {
 	InsPrevID *TreeNodeID
 	InsNextID *TreeNodeID
 
+	// movedAt is the time when the node took its current position by TreeMove.
+	// If the node is a slot, it is the time when the position was taken.
+	movedAt *time.Ticket
+
+	// MovedNodeID is optional. If the value is not empty, it means that the
+	// node is a slot of TreeMove, a removed node that keeps the position that
+	// the moved node of the ID has left or has not taken yet.
+	MovedNodeID *TreeNodeID
+
 	// Value is optional. If the value is not empty, it means that the node is a
 	// text node.
 	Value string
@@ -189,6 +255,28 @@ func (n *TreeNode) IsRemoved() bool {
 	return n.removedAt != nil
 }
 
+// MovedAt returns the time when the node took its current position by
+// TreeMove.
+func (n *TreeNode) MovedAt() *time.Ticket {
+	return n.movedAt
+}
+
+// SetMovedAt sets the time when the node took its current position by
+// TreeMove.
+func (n *TreeNode) SetMovedAt(ticket *time.Ticket) {
+	n.movedAt = ticket
+}
+
+// insertedAt returns the time when the node took its current position. It is
+// used to order the siblings inserted at the same position concurrently.
+func (n *TreeNode) insertedAt() *time.Ticket {
+	if n.movedAt != nil {
+		return n.movedAt
+	}
+
+	return n.id.CreatedAt
+}
+
 // Length returns the length of this node.
 func (n *TreeNode) Length() int {
 	encoded := utf16.Encode([]rune(n.Value))
@@ -435,6 +523,8 @@ func (n *TreeNode) DeepCopy() (*TreeNode, error) {
 	clone.removedAt = n.removedAt
 	clone.InsPrevID = n.InsPrevID
 	clone.InsNextID = n.InsNextID
+	clone.movedAt = n.movedAt
+	clone.MovedNodeID = n.MovedNodeID
 
 	if n.IsText() {
 		return clone, nil
@@ -508,6 +598,15 @@ type Tree struct {
 	createdAt *time.Ticket
 	movedAt   *time.Ticket
 	removedAt *time.Ticket
+
+	// schema is the schema of this tree. It is local to the replica and is
+	// not synchronized with other replicas.
+	schema *TreeSchema
+
+	// moves is the moves of the nodes in the order of their time. A move is
+	// kept until its slot is purged, and it is restored from the slot when the
+	// tree is created from a snapshot.
+	moves []*treeMove
 }
 
 // NewTree creates a new instance of Tree.
@@ -518,8 +617,32 @@ func NewTree(root *TreeNode, createdAt *time.Ticket) *Tree {
 		createdAt:   createdAt,
 	}
 
+	var slots []*TreeNode
 	index.Traverse(tree.IndexTree, func(node *index.Node[*TreeNode], depth int) {
 		tree.NodeMapByID.Put(node.Value.id, node.Value)
+		if node.Value.MovedNodeID != nil {
+			slots = append(slots, node.Value)
+		}
+	})
+
+	// NOTE: The slot of a move is created at the time of the move, and it
+	// takes over the time of the position that the node has left when the
+	// move is applied.
+	for _, slot := range slots {
+		node := tree.findNode(slot.MovedNodeID)
+		if node == nil {
+			continue
+		}
+
+		tree.moves = append(tree.moves, &treeMove{
+			movedAt: slot.id.CreatedAt,
+			node:    node,
+			slot:    slot,
+			applied: slot.insertedAt().Compare(slot.id.CreatedAt) != 0,
+		})
+	}
+	sort.Slice(tree.moves, func(i, j int) bool {
+		return tree.moves[j].movedAt.After(tree.moves[i].movedAt)
 	})
 
 	return tree
@@ -541,6 +664,12 @@ func (t *Tree) Purge(child GCChild) error {
 	}
 	t.NodeMapByID.Remove(node.id)
 
+	// NOTE: The moves of the purged node or of the purged slot are no longer
+	// undone or redone.
+	t.moves = slices.DeleteFunc(t.moves, func(move *treeMove) bool {
+		return move.node == node || move.slot == node
+	})
+
 	insPrevID := node.InsPrevID
 	insNextID := node.InsNextID
 	if insPrevID != nil {
@@ -588,7 +717,9 @@ func (t *Tree) DeepCopy() (Element, error) {
 		return nil, err
 	}
 
-	return NewTree(node, t.createdAt), nil
+	tree := NewTree(node, t.createdAt)
+	tree.schema = t.schema
+	return tree, nil
 }
 
 // GCPairs returns the pairs of GC.
@@ -656,6 +787,27 @@ func (t *Tree) Nodes() []*TreeNode {
 	return nodes
 }
 
+// Schema returns the schema of this tree. It returns nil if the tree has no
+// schema.
+func (t *Tree) Schema() *TreeSchema {
+	return t.schema
+}
+
+// SetSchema sets the schema of this tree.
+func (t *Tree) SetSchema(schema *TreeSchema) {
+	t.schema = schema
+}
+
+// ValidateSchema validates the nodes of this tree with its schema. It returns
+// nil if the tree has no schema.
+func (t *Tree) ValidateSchema() error {
+	if t.schema == nil {
+		return nil
+	}
+
+	return t.schema.Validate(t.Root())
+}
+
 // Root returns the root node of the tree.
 func (t *Tree) Root() *TreeNode {
 	return t.IndexTree.Root().Value
@@ -738,6 +890,36 @@ func (t *Tree) Edit(
 	issueTimeTicket func() *time.Ticket,
 	maxCreatedAtMapByActor map[string]*time.Ticket,
 	versionVector time.VersionVector,
+) (map[string]*time.Ticket, []GCPair, error) {
+	movesFrom, err := t.rewind(maxCreatedAtMapByActor, versionVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maxCreatedAtMap, pairs, err := t.edit(
+		from, to, contents, splitLevel, editedAt, issueTimeTicket,
+		maxCreatedAtMapByActor, versionVector,
+	)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := t.replay(movesFrom); err != nil {
+		return nil, nil, err
+	}
+
+	return maxCreatedAtMap, pairs, nil
+}
+
+// edit edits the tree with the given range and content.
+func (t *Tree) edit(
+	from, to *TreePos,
+	contents []*TreeNode,
+	splitLevel int,
+	editedAt *time.Ticket,
+	issueTimeTicket func() *time.Ticket,
+	maxCreatedAtMapByActor map[string]*time.Ticket,
+	versionVector time.VersionVector,
 ) (map[string]*time.Ticket, []GCPair, error) {
 	// 01. find nodes from the given range and split nodes.
 	fromParent, fromLeft, err := t.FindTreeNodesWithSplitText(from, editedAt)
@@ -967,6 +1149,219 @@ func (t *Tree) traverseInPosRange(fromParent, fromLeft, toParent, toLeft *TreeNo
 	return t.IndexTree.TokensBetween(fromIdx, toIdx, callback)
 }
 
+// Move moves the node of the given ID to the given target position. The node
+// keeps its identity, so the edits inside the node made concurrently by other
+// clients are kept in the moved node.
+//
+// NOTE: The move takes the target position by inserting a slot, a removed
+// node, and then swapping the node with it. The slots keep the positions that
+// the node has left or has not taken yet, so the moves are undone and redone
+// to be applied in the order of their time: if the same node is moved
+// concurrently, the last move wins, and the move that would make the node a
+// descendant of itself is skipped.
+func (t *Tree) Move(
+	id *TreeNodeID,
+	target *TreePos,
+	movedAt *time.Ticket,
+	versionVector time.VersionVector,
+) ([]GCPair, error) {
+	from, err := t.rewind(nil, versionVector)
+	if err != nil {
+		return nil, err
+	}
+
+	node := t.findNode(id)
+	if node == nil {
+		return nil, fmt.Errorf("%s: %w", id.toIDString(), ErrNodeNotFound)
+	}
+
+	parent, left, err := t.FindTreeNodesWithSplitText(target, movedAt)
+	if err != nil {
+		return nil, err
+	}
+
+	slot := NewTreeNode(&TreeNodeID{CreatedAt: movedAt, Offset: 0}, node.Type(), nil)
+	slot.movedAt = movedAt
+	slot.MovedNodeID = node.id
+	if parent == left {
+		err = parent.InsertAt(slot, 0)
+	} else {
+		err = parent.InsertAfter(slot, left)
+	}
+	if err != nil {
+		return nil, err
+	}
+	slot.remove(movedAt)
+	t.NodeMapByID.Put(slot.id, slot)
+
+	idx := len(t.moves)
+	for idx > 0 && t.moves[idx-1].movedAt.After(movedAt) {
+		idx--
+	}
+	t.moves = slices.Insert(t.moves, idx, &treeMove{movedAt: movedAt, node: node, slot: slot})
+	if err := t.replay(min(from, idx)); err != nil {
+		return nil, err
+	}
+
+	return []GCPair{{Parent: t, Child: slot}}, nil
+}
+
+// treeMove is a move of a node in Tree. The slot is swapped with the node
+// whenever the move is undone or redone, so it keeps the position that the
+// node has left if the move is applied, or the target position if not.
+type treeMove struct {
+	movedAt *time.Ticket
+	node    *TreeNode
+	slot    *TreeNode
+	applied bool
+}
+
+// rewind undoes the moves that the issuer of the operation has not seen, so
+// that the positions of the operation are resolved in the same arrangement of
+// the nodes as the issuer. It returns the index of the first undone move to
+// replay after the operation.
+func (t *Tree) rewind(
+	maxCreatedAtMapByActor map[string]*time.Ticket,
+	versionVector time.VersionVector,
+) (int, error) {
+	from := len(t.moves)
+	for i, move := range t.moves {
+		if !isSeen(move.movedAt, maxCreatedAtMapByActor, versionVector) {
+			from = i
+			break
+		}
+	}
+
+	for i := len(t.moves) - 1; i >= from; i-- {
+		if err := t.undoMove(t.moves[i]); err != nil {
+			return 0, err
+		}
+	}
+	for _, move := range t.moves[from:] {
+		if !isSeen(move.movedAt, maxCreatedAtMapByActor, versionVector) {
+			continue
+		}
+		if err := t.redoMove(move); err != nil {
+			return 0, err
+		}
+	}
+
+	return from, nil
+}
+
+// replay undoes the moves from the given index and redoes them in the order
+// of their time.
+func (t *Tree) replay(from int) error {
+	for i := len(t.moves) - 1; i >= from; i-- {
+		if err := t.undoMove(t.moves[i]); err != nil {
+			return err
+		}
+	}
+	for _, move := range t.moves[from:] {
+		if err := t.redoMove(move); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// undoMove puts the moved node back to the position that it has left.
+func (t *Tree) undoMove(move *treeMove) error {
+	if !move.applied {
+		return nil
+	}
+
+	if err := t.swap(move.node, move.slot); err != nil {
+		return err
+	}
+	move.applied = false
+	return nil
+}
+
+// redoMove moves the node to the target position again. It skips the move if
+// the target position is in the node itself.
+func (t *Tree) redoMove(move *treeMove) error {
+	if move.applied || move.slot.Index.Parent == nil || move.node.Index.Parent == nil ||
+		move.node.Index.IsAncestorOf(move.slot.Index) {
+		return nil
+	}
+
+	if err := t.swap(move.node, move.slot); err != nil {
+		return err
+	}
+	move.applied = true
+	return nil
+}
+
+// swap exchanges the positions of the given node and the slot. The slot takes
+// over the time when the node took its position, and vice versa.
+func (t *Tree) swap(node, slot *TreeNode) error {
+	if err := index.Swap(node.Index, slot.Index); err != nil {
+		return err
+	}
+
+	node.movedAt, slot.movedAt = slot.movedAt, node.insertedAt()
+	if node.movedAt.Compare(node.id.CreatedAt) == 0 {
+		node.movedAt = nil
+	}
+
+	return nil
+}
+
+// isSeen returns whether the issuer of the operation has seen the change of
+// the given ticket.
+func isSeen(
+	ticket *time.Ticket,
+	maxCreatedAtMapByActor map[string]*time.Ticket,
+	versionVector time.VersionVector,
+) bool {
+	if len(versionVector) == 0 && len(maxCreatedAtMapByActor) == 0 {
+		// Case 1: local editing from json package
+		return true
+	} else if len(versionVector) != 0 {
+		// Case 2: from operation with version vector(After v0.5.7)
+		lamport, ok := versionVector.Get(ticket.ActorID())
+		return ok && ticket.Lamport() <= lamport
+	}
+
+	// Case 3: from operation without version vector(Before v0.5.6)
+	createdAt, ok := maxCreatedAtMapByActor[ticket.ActorIDHex()]
+	return ok && !ticket.After(createdAt)
+}
+
+// NodeRange returns the range of the node of the given ID as indexes. It
+// returns ErrNodeNotFound if the node is removed.
+func (t *Tree) NodeRange(id *TreeNodeID) (int, int, error) {
+	node := t.findNode(id)
+	if node == nil {
+		return 0, 0, fmt.Errorf("%s: %w", id.toIDString(), ErrNodeNotFound)
+	}
+
+	for current := node; current != t.Root(); current = current.Index.Parent.Value {
+		if current.IsRemoved() || current.Index.Parent == nil {
+			return 0, 0, fmt.Errorf("%s: %w", id.toIDString(), ErrNodeNotFound)
+		}
+	}
+	if node == t.Root() {
+		return 0, 0, fmt.Errorf("%s: %w", id.toIDString(), ErrNodeNotFound)
+	}
+
+	offset, err := node.Index.Parent.FindOffset(node.Index)
+	if err != nil {
+		return 0, 0, err
+	}
+	from, err := t.IndexTree.IndexOf(&index.TreePos[*TreeNode]{
+		Node:   node.Index.Parent,
+		Offset: offset,
+	})
+	if err != nil {
+		return 0, 0, err
+	}
+
+	return from, from + node.Index.PaddedLength(), nil
+}
+
 // StyleByIndex applies the given attributes of the given range.
 // This method uses indexes instead of a pair of TreePos for testing.
 func (t *Tree) StyleByIndex(
@@ -996,6 +1391,31 @@ func (t *Tree) Style(
 	editedAt *time.Ticket,
 	maxCreatedAtMapByActor map[string]*time.Ticket,
 	versionVector time.VersionVector,
+) (map[string]*time.Ticket, []GCPair, error) {
+	movesFrom, err := t.rewind(maxCreatedAtMapByActor, versionVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maxCreatedAtMap, pairs, err := t.style(from, to, attrs, editedAt, maxCreatedAtMapByActor, versionVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := t.replay(movesFrom); err != nil {
+		return nil, nil, err
+	}
+
+	return maxCreatedAtMap, pairs, nil
+}
+
+// style applies the given attributes of the given range.
+func (t *Tree) style(
+	from, to *TreePos,
+	attrs map[string]string,
+	editedAt *time.Ticket,
+	maxCreatedAtMapByActor map[string]*time.Ticket,
+	versionVector time.VersionVector,
 ) (map[string]*time.Ticket, []GCPair, error) {
 	fromParent, fromLeft, err := t.FindTreeNodesWithSplitText(from, editedAt)
 	if err != nil {
@@ -1070,6 +1490,32 @@ func (t *Tree) RemoveStyle(
 	editedAt *time.Ticket,
 	maxCreatedAtMapByActor map[string]*time.Ticket,
 	versionVector time.VersionVector,
+) (map[string]*time.Ticket, []GCPair, error) {
+	movesFrom, err := t.rewind(maxCreatedAtMapByActor, versionVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	maxCreatedAtMap, pairs, err := t.removeStyle(from, to, attrs, editedAt, maxCreatedAtMapByActor, versionVector)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	if err := t.replay(movesFrom); err != nil {
+		return nil, nil, err
+	}
+
+	return maxCreatedAtMap, pairs, nil
+}
+
+// removeStyle removes the given attributes of the given range.
+func (t *Tree) removeStyle(
+	from *TreePos,
+	to *TreePos,
+	attrs []string,
+	editedAt *time.Ticket,
+	maxCreatedAtMapByActor map[string]*time.Ticket,
+	versionVector time.VersionVector,
 ) (map[string]*time.Ticket, []GCPair, error) {
 	fromParent, fromLeft, err := t.FindTreeNodesWithSplitText(from, editedAt)
 	if err != nil {
@@ -1178,7 +1624,7 @@ func (t *Tree) FindTreeNodesWithSplitText(pos *TreePos, editedAt *time.Ticket) (
 	parentChildren := realParentNode.Index.Children(true)
 	for i := idx; i < len(parentChildren); i++ {
 		next := parentChildren[i].Value
-		if !next.id.CreatedAt.After(editedAt) {
+		if !next.insertedAt().After(editedAt) {
 			break
 		}
 		leftNode = next
@@ -1272,6 +1718,17 @@ func (t *Tree) ToPath(parentNode, leftSiblingNode *TreeNode) ([]int, error) {
 	return t.IndexTree.TreePosToPath(treePos)
 }
 
+// findNode returns the node of the given ID. Unlike findFloorNode, it does not
+// return the node that contains the given ID.
+func (t *Tree) findNode(id *TreeNodeID) *TreeNode {
+	key, node := t.NodeMapByID.Floor(id)
+	if node == nil || !key.Equals(id) {
+		return nil
+	}
+
+	return node
+}
+
 // findFloorNode returns node from given id.
 func (t *Tree) findFloorNode(id *TreeNodeID) *TreeNode {
 	key, node := t.NodeMapByID.Floor(id)
@@ -1328,6 +1785,53 @@ func (t *Tree) PathToPos(path []int) (*TreePos, error) {
 	return pos, nil
 }
 
+// PosToIndex returns the index of the given position.
+func (t *Tree) PosToIndex(pos *TreePos) (int, error) {
+	parentNode, leftNode := t.ToTreeNodes(pos)
+	if parentNode == nil || leftNode == nil {
+		return 0, fmt.Errorf("%p: %w", pos, ErrNodeNotFound)
+	}
+
+	idx, err := t.ToIndex(parentNode, leftNode)
+	if err != nil {
+		return 0, err
+	}
+	if idx < 0 {
+		return 0, fmt.Errorf("%p: %w", pos, ErrNodeNotFound)
+	}
+
+	// NOTE: ToIndex returns the index after the whole left sibling, so we
+	// need to adjust the index if the position is in the middle of the text.
+	if leftNode != parentNode && leftNode.IsText() && !leftNode.IsRemoved() {
+		offset := pos.LeftSiblingID.Offset - leftNode.id.Offset
+		if offset < leftNode.Len() {
+			idx -= leftNode.Len() - offset
+		}
+	}
+
+	return idx, nil
+}
+
+// IndexToPath returns the path of the given index.
+func (t *Tree) IndexToPath(idx int) ([]int, error) {
+	treePos, err := t.IndexTree.FindTreePos(idx)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.IndexTree.TreePosToPath(treePos)
+}
+
+// PosToPath returns the path of the given position.
+func (t *Tree) PosToPath(pos *TreePos) ([]int, error) {
+	idx, err := t.PosToIndex(pos)
+	if err != nil {
+		return nil, err
+	}
+
+	return t.IndexToPath(idx)
+}
+
 // ToTreeNodeForTest returns the JSON of this tree for debugging.
 func ToTreeNodeForTest(node *TreeNode) TreeNodeForTest {
 	if node.IsText() {
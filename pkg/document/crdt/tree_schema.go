@@ -0,0 +1,100 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package crdt
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"sort"
+)
+
+var (
+	// ErrTreeSchemaViolation is returned when the nodes of the tree violate
+	// the schema of the tree.
+	ErrTreeSchemaViolation = errors.New("tree schema violation")
+)
+
+// TreeSchema is the schema of Tree. It describes which types of nodes can be
+// the children of each type of element nodes and which attributes they can
+// have, e.g. `doc > paragraph > text`.
+type TreeSchema struct {
+	// Root is the type of the root node. If it is empty, the root node can be
+	// any type in Nodes.
+	Root string `json:"root,omitempty"`
+
+	// Nodes is the rules of element nodes by their types. Element nodes whose
+	// types are not in Nodes are not allowed.
+	Nodes map[string]TreeNodeRule `json:"nodes"`
+}
+
+// TreeNodeRule is the rule of element nodes of a type.
+type TreeNodeRule struct {
+	// Children is the types of nodes that can be the children of the node.
+	// "text" is the type of text nodes.
+	Children []string `json:"children,omitempty"`
+
+	// Attributes is the keys of attributes that the node can have.
+	Attributes []string `json:"attributes,omitempty"`
+}
+
+// Validate validates the given node and its descendants with this schema.
+// The removed nodes and attributes are ignored.
+func (s *TreeSchema) Validate(root *TreeNode) error {
+	if s.Root != "" && root.Type() != s.Root {
+		return fmt.Errorf("root should be %s, not %s: %w", s.Root, root.Type(), ErrTreeSchemaViolation)
+	}
+
+	return s.validate(root)
+}
+
+// validate validates the given element node and its descendants.
+func (s *TreeSchema) validate(node *TreeNode) error {
+	rule, ok := s.Nodes[node.Type()]
+	if !ok {
+		return fmt.Errorf("unknown node type %s: %w", node.Type(), ErrTreeSchemaViolation)
+	}
+
+	if node.Attrs != nil {
+		keys := make([]string, 0, node.Attrs.Len())
+		for k := range node.Attrs.Elements() {
+			keys = append(keys, k)
+		}
+		sort.Strings(keys)
+
+		for _, k := range keys {
+			if !slices.Contains(rule.Attributes, k) {
+				return fmt.Errorf("attribute %s of %s: %w", k, node.Type(), ErrTreeSchemaViolation)
+			}
+		}
+	}
+
+	for _, child := range node.Index.Children() {
+		if !slices.Contains(rule.Children, child.Value.Type()) {
+			return fmt.Errorf("%s under %s: %w", child.Value.Type(), node.Type(), ErrTreeSchemaViolation)
+		}
+
+		if child.Value.IsText() {
+			continue
+		}
+		if err := s.validate(child.Value); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
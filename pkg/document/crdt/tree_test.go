@@ -736,6 +736,170 @@ func TestTreeMerge(t *testing.T) {
 	})
 }
 
+func TestTreeMove(t *testing.T) {
+	newTree := func(ctx *change.Context, texts ...string) *crdt.Tree {
+		tree := crdt.NewTree(crdt.NewTreeNode(helper.PosT(ctx), "r", nil), helper.TimeT(ctx))
+		for _, text := range texts {
+			p := crdt.NewTreeNode(helper.PosT(ctx), "p", nil)
+			assert.NoError(t, p.Append(crdt.NewTreeNode(helper.PosT(ctx), "text", nil, text)))
+			assert.NoError(t, tree.EditT(
+				tree.Root().Len(), tree.Root().Len(), []*crdt.TreeNode{p}, 0, helper.TimeT(ctx), issueTimeTicket(ctx),
+			))
+		}
+		return tree
+	}
+	copyTree := func(tree *crdt.Tree) *crdt.Tree {
+		clone, err := tree.DeepCopy()
+		assert.NoError(t, err)
+		return clone.(*crdt.Tree)
+	}
+	actorA := time.InitialActorID
+	actorB, err := time.ActorIDFromHex("000000000000000000000001")
+	assert.NoError(t, err)
+
+	t.Run("move a node with Move test", func(t *testing.T) {
+		ctx := helper.TextChangeContext(helper.TestRoot())
+		tree := newTree(ctx, "hello", "world")
+		assert.Equal(t, "<r><p>hello</p><p>world</p></r>", tree.ToXML())
+
+		//       0   1 2 3 4 5 6    7   8 9  10 11 12 13    14
+		// <root> <p> h e l l o </p> <p> w  o  r  l  d  </p>  </root>
+		target, err := tree.FindPos(14)
+		assert.NoError(t, err)
+
+		node := tree.Root().Index.Children()[0].Value
+		pairs, err := tree.Move(node.ID(), target, helper.TimeT(ctx), nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "<r><p>world</p><p>hello</p></r>", tree.ToXML())
+		assert.Equal(t, 14, tree.Root().Len())
+		assert.Len(t, pairs, 1)
+
+		// NOTE: The moved node keeps its identity and the slot is left at
+		// the position that the node has left.
+		assert.Same(t, node, tree.Root().Index.Children()[1].Value)
+		slot := tree.Root().Index.Children(true)[0].Value
+		assert.True(t, slot.IsRemoved())
+		assert.Equal(t, node.ID(), slot.MovedNodeID)
+	})
+
+	t.Run("last move wins in concurrent moves test", func(t *testing.T) {
+		ctx := helper.TextChangeContext(helper.TestRoot())
+		tree := newTree(ctx, "a", "b")
+
+		// <root> <p> a </p> <p> b </p> </root>
+		intoB, err := tree.FindPos(5)
+		assert.NoError(t, err)
+		back, err := tree.FindPos(6)
+		assert.NoError(t, err)
+		id := tree.Root().Index.Children()[0].Value.ID()
+		earlier, later := helper.TimeT(ctx), helper.TimeT(ctx)
+
+		tree1, tree2 := copyTree(tree), copyTree(tree)
+		_, err = tree1.Move(id, intoB, earlier, nil)
+		assert.NoError(t, err)
+		_, err = tree1.Move(id, back, later, nil)
+		assert.NoError(t, err)
+
+		_, err = tree2.Move(id, back, later, nil)
+		assert.NoError(t, err)
+		_, err = tree2.Move(id, intoB, earlier, nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "<r><p>b</p><p>a</p></r>", tree1.ToXML())
+		assert.Equal(t, tree1.ToXML(), tree2.ToXML())
+		assert.Equal(t, tree1.Root().Len(), tree2.Root().Len())
+	})
+
+	t.Run("skip move that makes cycle test", func(t *testing.T) {
+		ctx := helper.TextChangeContext(helper.TestRoot())
+		tree := newTree(ctx, "a", "b")
+
+		// <root> <p> a </p> <p> b </p> </root>
+		intoA, err := tree.FindPos(2)
+		assert.NoError(t, err)
+		intoB, err := tree.FindPos(5)
+		assert.NoError(t, err)
+		a := tree.Root().Index.Children()[0].Value.ID()
+		b := tree.Root().Index.Children()[1].Value.ID()
+		earlier, later := helper.TimeT(ctx), helper.TimeT(ctx)
+
+		tree1, tree2 := copyTree(tree), copyTree(tree)
+		_, err = tree1.Move(a, intoB, earlier, nil)
+		assert.NoError(t, err)
+		_, err = tree1.Move(b, intoA, later, nil)
+		assert.NoError(t, err)
+
+		_, err = tree2.Move(b, intoA, later, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "<r><p>a<p>b</p></p></r>", tree2.ToXML())
+		_, err = tree2.Move(a, intoB, earlier, nil)
+		assert.NoError(t, err)
+
+		assert.Equal(t, "<r><p>b<p>a</p></p></r>", tree1.ToXML())
+		assert.Equal(t, tree1.ToXML(), tree2.ToXML())
+	})
+
+	t.Run("apply concurrent edit to the moved node test", func(t *testing.T) {
+		ctx := helper.TextChangeContext(helper.TestRoot())
+		tree := newTree(ctx, "x", "ab", "cd")
+
+		//       0   1 2    3   4 5 6    7   8 9 10    11
+		// <root> <p> x </p> <p> a b </p> <p> c d  </p>  </root>
+		back, err := tree.FindPos(11)
+		assert.NoError(t, err)
+		from, err := tree.FindPos(2)
+		assert.NoError(t, err)
+		to, err := tree.FindPos(5)
+		assert.NoError(t, err)
+		id := tree.Root().Index.Children()[1].Value.ID()
+
+		// NOTE: B deletes the range from "x" to "a" without seeing the move of
+		// A, so the range should not cover the nodes between them after the
+		// move.
+		moved := time.NewTicket(1, 1, actorA)
+		edited := time.NewTicket(1, 1, actorB)
+		vv := helper.VersionVectorOf(map[*time.ActorID]int64{actorA: 0, actorB: 1})
+
+		tree1, tree2 := copyTree(tree), copyTree(tree)
+		_, err = tree1.Move(id, back, moved, nil)
+		assert.NoError(t, err)
+		_, _, err = tree1.Edit(from, to, nil, 0, edited, nil, nil, vv)
+		assert.NoError(t, err)
+
+		_, _, err = tree2.Edit(from, to, nil, 0, edited, nil, nil, vv)
+		assert.NoError(t, err)
+		_, err = tree2.Move(id, back, moved, helper.VersionVectorOf(map[*time.ActorID]int64{actorA: 1}))
+		assert.NoError(t, err)
+
+		assert.Equal(t, "<r><p>xb</p><p>cd</p></r>", tree1.ToXML())
+		assert.Equal(t, tree1.ToXML(), tree2.ToXML())
+		assert.Equal(t, tree1.Root().Len(), tree2.Root().Len())
+	})
+
+	t.Run("restore moves from snapshot test", func(t *testing.T) {
+		ctx := helper.TextChangeContext(helper.TestRoot())
+		tree := newTree(ctx, "a", "b")
+
+		// <root> <p> a </p> <p> b </p> </root>
+		intoB, err := tree.FindPos(5)
+		assert.NoError(t, err)
+		back, err := tree.FindPos(6)
+		assert.NoError(t, err)
+		id := tree.Root().Index.Children()[0].Value.ID()
+		earlier, later := helper.TimeT(ctx), helper.TimeT(ctx)
+
+		_, err = tree.Move(id, back, later, nil)
+		assert.NoError(t, err)
+
+		// NOTE: The moves are restored from the slots, so the earlier move
+		// arrived later is applied before the later move.
+		clone := copyTree(tree)
+		_, err = clone.Move(id, intoB, earlier, nil)
+		assert.NoError(t, err)
+		assert.Equal(t, "<r><p>b</p><p>a</p></r>", clone.ToXML())
+	})
+}
+
 func issueTimeTicket(change *change.Context) func() *time.Ticket {
 	return func() *time.Ticket {
 		return helper.TimeT(change)
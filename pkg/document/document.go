@@ -21,6 +21,11 @@ import (
 	gojson "encoding/json"
 	"errors"
 	"fmt"
+	"runtime"
+	"strconv"
+	"strings"
+	gosync "sync"
+	"sync/atomic"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
@@ -40,6 +45,10 @@ var (
 type DocEvent struct {
 	Type      DocEventType
 	Presences map[string]innerpresence.Presence
+
+	// Change is the information of the applied change. It is set only for
+	// LocalChangeEvent and RemoteChangeEvent.
+	Change *ChangeInfo
 }
 
 // DocEventType represents the type of the event that occurred in the document.
@@ -57,6 +66,14 @@ const (
 	// PresenceChangedEvent means that the presences of the clients who are editing
 	// the document have changed.
 	PresenceChangedEvent DocEventType = "presence-changed"
+
+	// LocalChangeEvent means that the local change has been applied to the
+	// document by Update.
+	LocalChangeEvent DocEventType = "local-change"
+
+	// RemoteChangeEvent means that the remote change has been applied to the
+	// document by ApplyChangePack.
+	RemoteChangeEvent DocEventType = "remote-change"
 )
 
 // BroadcastRequest represents a broadcast request that will be delivered to the client.
@@ -74,6 +91,10 @@ type Options struct {
 	// NOTE(hackerwins): This is temporary option. We need to remove this option
 	// after introducing the garbage collection based on the version vector.
 	DisableGC bool
+
+	// ChangeEvents sends LocalChangeEvent and RemoteChangeEvent to Events as
+	// well as to the subscriptions.
+	ChangeEvents bool
 }
 
 // WithDisableGC configures the document to disable garbage collection.
@@ -83,6 +104,16 @@ func WithDisableGC() Option {
 	}
 }
 
+// WithChangeEvents configures the document to send LocalChangeEvent and
+// RemoteChangeEvent to Events. The events are queued so that the changes are
+// not blocked by the receiver of Events, e.g. while attaching the document,
+// so the receiver should keep receiving them not to pile them up.
+func WithChangeEvents() Option {
+	return func(o *Options) {
+		o.ChangeEvents = true
+	}
+}
+
 // Document represents a document accessible to the user.
 //
 // How document works:
@@ -91,6 +122,20 @@ func WithDisableGC() Option {
 // root. This is to protect the base json from errors that may occur while user
 // edit the document.
 type Document struct {
+	// mu guards the document from the concurrent access, e.g. the background
+	// sync of the client while the user is updating the document. It is
+	// locked with lock so that the goroutine holding it can re-enter.
+	mu gosync.Mutex
+
+	// owner is the ID of the goroutine holding mu, and depth is the number of
+	// the nested locks of the owner.
+	owner atomic.Int64
+	depth int
+
+	// backgroundSync is whether the document is synchronized in background,
+	// e.g. by the client attaching it with WithAutoSync.
+	backgroundSync atomic.Bool
+
 	// doc is the original data of the actual document.
 	doc *InternalDocument
 
@@ -108,6 +153,28 @@ type Document struct {
 	// events is the channel to send events that occurred in the document.
 	events chan DocEvent
 
+	// history is the undo/redo history of the local changes.
+	history *History
+
+	// changeSubscriptions is the list of subscriptions to the local and
+	// remote changes of the document.
+	changeSubscriptions []*changeSubscription
+
+	// changeEvents is the list of the change events to be published after
+	// the document is unlocked.
+	changeEvents []DocEvent
+
+	// pendingEvents is the list of the events to be sent to `events` after
+	// the document is unlocked.
+	pendingEvents []DocEvent
+
+	// eventQueue is the queue of the events to be sent to `events` in
+	// background. It is used instead of sending them directly only if
+	// ChangeEvents is set. queueMu guards it and sendingEvents.
+	queueMu       gosync.Mutex
+	eventQueue    []DocEvent
+	sendingEvents bool
+
 	// broadcastRequests is the send-only channel to send broadcast requests.
 	broadcastRequests chan BroadcastRequest
 
@@ -127,7 +194,7 @@ func New(key key.Key, opts ...Option) *Document {
 		opt(&options)
 	}
 
-	return &Document{
+	doc := &Document{
 		doc:                NewInternalDocument(key),
 		options:            options,
 		events:             make(chan DocEvent, 1),
@@ -137,13 +204,21 @@ func New(key key.Key, opts ...Option) *Document {
 			topic, publisher string,
 			payload []byte) error),
 	}
+	doc.history = newHistory(doc)
+
+	return doc
 }
 
-// Update executes the given updater to update this document.
+// Update executes the given updater to update this document. The document is
+// locked while the updater is executed, and the updater can call the methods
+// of the same document, e.g. Root and GarbageCollect, on the same goroutine.
 func (d *Document) Update(
 	updater func(root *json.Object, p *presence.Presence) error,
 	msgAndArgs ...interface{},
 ) error {
+	d.lock()
+	defer d.unlock()
+
 	if d.doc.status == StatusRemoved {
 		return ErrDocumentRemoved
 	}
@@ -169,20 +244,107 @@ func (d *Document) Update(
 	}
 
 	if ctx.HasChange() {
-		c := ctx.ToChange()
-		if err := c.Execute(d.doc.root, d.doc.presences); err != nil {
+		reverse, err := d.commit(ctx)
+		if err != nil {
 			return err
 		}
-
-		d.doc.localChanges = append(d.doc.localChanges, c)
-		d.doc.changeID = ctx.ID()
+		d.history.pushUndo(reverse)
 	}
 
 	return nil
 }
 
+// History returns the undo/redo history of this document.
+func (d *Document) History() *History {
+	return d.history
+}
+
+// commit applies the change of the given context to the document and returns
+// the reverse operations of the change.
+func (d *Document) commit(ctx *change.Context) ([]historyOperation, error) {
+	var reverse []historyOperation
+	var infos []OperationInfo
+	c := ctx.ToChange()
+	if err := c.ExecuteWith(
+		d.doc.root,
+		d.doc.presences,
+		change.ChainInterceptors(
+			interceptLocal(d.doc.root, &reverse),
+			collectOperationInfos(d.doc.root, &infos, nil),
+		),
+	); err != nil {
+		return nil, err
+	}
+
+	d.doc.localChanges = append(d.doc.localChanges, c)
+	d.doc.changeID = ctx.ID()
+
+	if len(infos) > 0 || c.PresenceChange() != nil {
+		d.addChangeEvent(DocEvent{
+			Type:   LocalChangeEvent,
+			Change: newChangeInfo(c, infos),
+		})
+	}
+
+	return reverse, nil
+}
+
+// executeHistory executes the given history operations as a new local change
+// and returns the reverse operations of the change. The given operations and
+// the history are not modified if the execution fails.
+func (d *Document) executeHistory(entry []historyOperation) ([]historyOperation, error) {
+	if d.doc.status == StatusRemoved {
+		return nil, ErrDocumentRemoved
+	}
+
+	if err := d.ensureClone(); err != nil {
+		return nil, err
+	}
+
+	ops := make([]historyOperation, 0, len(entry))
+	for _, op := range entry {
+		ops = append(ops, op.clone())
+	}
+
+	var mappings []map[string]*time.Ticket
+	ctx := change.NewContext(d.doc.changeID.Next(), "", d.cloneRoot)
+	for i, op := range ops {
+		mapping := make(map[string]*time.Ticket)
+		if err := op.execute(ctx, d.cloneRoot, mapping); err != nil {
+			// drop cloneRoot because it is contaminated.
+			d.cloneRoot = nil
+			d.clonePresences = nil
+			return nil, err
+		}
+
+		if len(mapping) > 0 {
+			for _, next := range ops[i+1:] {
+				next.remap(mapping)
+			}
+			mappings = append(mappings, mapping)
+		}
+	}
+
+	if !ctx.HasChange() {
+		return nil, nil
+	}
+
+	reverse, err := d.commit(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, mapping := range mappings {
+		d.history.remap(mapping)
+	}
+	return reverse, nil
+}
+
 // ApplyChangePack applies the given change pack into this document.
 func (d *Document) ApplyChangePack(pack *change.Pack) error {
+	d.lock()
+	defer d.unlock()
+
 	// 01. Apply remote changes to both the cloneRoot and the document.
 	hasSnapshot := len(pack.Snapshot) > 0
 
@@ -192,6 +354,10 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 		if err := d.doc.applySnapshot(pack.Snapshot, pack.VersionVector); err != nil {
 			return err
 		}
+
+		// NOTE: The positions in the history cannot be rebased on the snapshot,
+		// so we clear the history.
+		d.history.Clear()
 	} else {
 		if err := d.applyChanges(pack.Changes); err != nil {
 			return err
@@ -199,7 +365,7 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 	}
 
 	// 02. Remove local changes applied to server.
-	for d.HasLocalChanges() {
+	for d.doc.HasLocalChanges() {
 		c := d.doc.localChanges[0]
 		if c.ClientSeq() > pack.Checkpoint.ClientSeq {
 			break
@@ -218,7 +384,7 @@ func (d *Document) ApplyChangePack(pack *change.Pack) error {
 
 	// 04. Do Garbage collection.
 	if !d.options.DisableGC && !hasSnapshot {
-		d.GarbageCollect(pack.VersionVector)
+		d.garbageCollect(pack.VersionVector)
 	}
 
 	// 05. Update the status.
@@ -235,22 +401,236 @@ func (d *Document) applyChanges(changes []*change.Change) error {
 	}
 
 	for _, c := range changes {
-		if err := c.Execute(d.cloneRoot, d.clonePresences); err != nil {
+		if err := c.ExecuteWith(
+			d.cloneRoot,
+			d.clonePresences,
+			d.history.interceptRemote(d.cloneRoot),
+		); err != nil {
 			return err
 		}
 	}
 
-	events, err := d.doc.ApplyChanges(changes...)
+	// NOTE: Building the patches is not free, so they are built only if
+	// there is a subscription to them.
+	applyChanges := d.doc.ApplyChanges
+	if d.hasPatchSubscription() {
+		applyChanges = d.doc.ApplyChangesWithPatches
+	}
+	events, err := applyChanges(changes...)
 	if err != nil {
 		return err
 	}
 
 	for _, e := range events {
-		d.events <- e
+		if e.Type == RemoteChangeEvent {
+			d.addChangeEvent(e)
+			continue
+		}
+		d.pendingEvents = append(d.pendingEvents, e)
 	}
 	return nil
 }
 
+// addChangeEvent adds the given change event to be published after the
+// document is unlocked. It is also sent to Events if ChangeEvents is set.
+func (d *Document) addChangeEvent(e DocEvent) {
+	d.changeEvents = append(d.changeEvents, e)
+	if d.options.ChangeEvents {
+		d.pendingEvents = append(d.pendingEvents, e)
+	}
+}
+
+// changeSubscription represents a subscription to the changes of the document.
+// If subPaths is nil, the subscription receives all the changes. If patches
+// is set, the subscription receives only the remote changes with the patches.
+type changeSubscription struct {
+	subPaths []string
+	patches  bool
+	fn       func(DocEvent)
+}
+
+// SubscribeChanges registers the given callback to be called with
+// LocalChangeEvent and RemoteChangeEvent whenever changes are applied to the
+// document. It returns a function to unsubscribe.
+func (d *Document) SubscribeChanges(fn func(DocEvent)) func() {
+	return d.subscribe(&changeSubscription{fn: fn})
+}
+
+// SubscribePatches registers the given callback to be called with
+// RemoteChangeEvent whenever remote changes are applied to the document. The
+// events carry the JSON Patch(RFC 6902) of the changes in Change.Patches, and
+// the patches are built only while there are the subscriptions. It returns a
+// function to unsubscribe.
+func (d *Document) SubscribePatches(fn func(DocEvent)) func() {
+	return d.subscribe(&changeSubscription{patches: true, fn: fn})
+}
+
+// hasPatchSubscription returns whether there is a subscription to the patches.
+func (d *Document) hasPatchSubscription() bool {
+	for _, sub := range d.changeSubscriptions {
+		if sub.patches {
+			return true
+		}
+	}
+	return false
+}
+
+// Subscribe registers the given callback to be called with LocalChangeEvent
+// and RemoteChangeEvent only when the operations touch the given path, e.g.
+// "$.todos", or its descendants. The events carry only the operations related
+// to the path. It returns a function to unsubscribe.
+func (d *Document) Subscribe(path string, fn func(DocEvent)) (func(), error) {
+	subPaths, err := crdt.SplitPath(path)
+	if err != nil {
+		return nil, err
+	}
+
+	return d.subscribe(&changeSubscription{subPaths: subPaths, fn: fn}), nil
+}
+
+func (d *Document) subscribe(sub *changeSubscription) func() {
+	d.lock()
+	defer d.unlock()
+
+	d.changeSubscriptions = append(d.changeSubscriptions, sub)
+
+	return func() {
+		d.lock()
+		defer d.unlock()
+
+		for i, s := range d.changeSubscriptions {
+			if s == sub {
+				// NOTE: A new slice is allocated so that the publishing in
+				// progress is not affected.
+				d.changeSubscriptions = append(d.changeSubscriptions[:i:i], d.changeSubscriptions[i+1:]...)
+				return
+			}
+		}
+	}
+}
+
+// lock locks the document. The goroutine holding the lock can lock it again,
+// e.g. when the updater calls Root of the same document, and the lock is
+// released by the outermost unlock.
+func (d *Document) lock() {
+	id := goroutineID()
+	if d.owner.Load() == id {
+		d.depth++
+		return
+	}
+
+	d.mu.Lock()
+	d.owner.Store(id)
+	d.depth = 1
+}
+
+// unlock unlocks the document and publishes the events made while it was
+// locked. The events are published after unlocking so that the subscriptions
+// and the receivers of Events can access the document.
+func (d *Document) unlock() {
+	d.depth--
+	if d.depth > 0 {
+		return
+	}
+	d.owner.Store(0)
+
+	changeEvents := d.changeEvents
+	pendingEvents := d.pendingEvents
+	subs := d.changeSubscriptions
+	d.changeEvents = nil
+	d.pendingEvents = nil
+	d.mu.Unlock()
+
+	for _, e := range changeEvents {
+		publishChange(subs, e)
+	}
+	if d.options.ChangeEvents {
+		d.queueEvents(pendingEvents)
+		return
+	}
+	for _, e := range pendingEvents {
+		d.events <- e
+	}
+}
+
+// goroutineID returns the ID of the current goroutine. It is parsed from the
+// header of the stack trace, e.g. "goroutine 18 [running]:".
+func goroutineID() int64 {
+	var buf [64]byte
+	n := runtime.Stack(buf[:], false)
+	fields := strings.Fields(string(buf[:n]))
+	if len(fields) < 2 {
+		panic(fmt.Sprintf("parse goroutine id: %q", buf[:n]))
+	}
+
+	id, err := strconv.ParseInt(fields[1], 10, 64)
+	if err != nil {
+		panic(fmt.Errorf("parse goroutine id: %w", err))
+	}
+	return id
+}
+
+// queueEvents queues the given events to be sent to `events` in background.
+func (d *Document) queueEvents(events []DocEvent) {
+	if len(events) == 0 {
+		return
+	}
+
+	d.queueMu.Lock()
+	defer d.queueMu.Unlock()
+
+	d.eventQueue = append(d.eventQueue, events...)
+	if !d.sendingEvents {
+		d.sendingEvents = true
+		go d.sendQueuedEvents()
+	}
+}
+
+// sendQueuedEvents sends the queued events to `events` in order until the
+// queue is empty.
+func (d *Document) sendQueuedEvents() {
+	for {
+		d.queueMu.Lock()
+		if len(d.eventQueue) == 0 {
+			d.sendingEvents = false
+			d.queueMu.Unlock()
+			return
+		}
+		e := d.eventQueue[0]
+		d.eventQueue = d.eventQueue[1:]
+		d.queueMu.Unlock()
+
+		d.events <- e
+	}
+}
+
+// publishChange calls the given subscriptions with the given change event.
+func publishChange(subs []*changeSubscription, e DocEvent) {
+	for _, sub := range subs {
+		if sub.patches && e.Type != RemoteChangeEvent {
+			continue
+		}
+		if sub.subPaths == nil {
+			sub.fn(e)
+			continue
+		}
+
+		var infos []OperationInfo
+		for _, info := range e.Change.Operations {
+			if info.touches(sub.subPaths) {
+				infos = append(infos, info)
+			}
+		}
+		if len(infos) == 0 {
+			continue
+		}
+
+		changeInfo := *e.Change
+		changeInfo.Operations = infos
+		sub.fn(DocEvent{Type: e.Type, Change: &changeInfo})
+	}
+}
+
 // InternalDocument returns the internal document.
 func (d *Document) InternalDocument() *InternalDocument {
 	return d.doc
@@ -263,21 +643,33 @@ func (d *Document) Key() key.Key {
 
 // Checkpoint returns the checkpoint of this document.
 func (d *Document) Checkpoint() change.Checkpoint {
+	d.lock()
+	defer d.unlock()
+
 	return d.doc.checkpoint
 }
 
 // HasLocalChanges returns whether this document has local changes or not.
 func (d *Document) HasLocalChanges() bool {
+	d.lock()
+	defer d.unlock()
+
 	return d.doc.HasLocalChanges()
 }
 
 // Marshal returns the JSON encoding of this document.
 func (d *Document) Marshal() string {
+	d.lock()
+	defer d.unlock()
+
 	return d.doc.Marshal()
 }
 
 // CreateChangePack creates pack of the local changes to send to the server.
 func (d *Document) CreateChangePack() *change.Pack {
+	d.lock()
+	defer d.unlock()
+
 	return d.doc.CreateChangePack()
 }
 
@@ -317,18 +709,75 @@ func (d *Document) RootObject() *crdt.Object {
 	return d.doc.RootObject()
 }
 
-// Root returns the root object of this document.
+// SetBackgroundSync sets whether this document is synchronized in background.
+// While it is set, the remote changes are applied concurrently with the user,
+// so Root returns a snapshot of the root.
+func (d *Document) SetBackgroundSync(enabled bool) {
+	d.backgroundSync.Store(enabled)
+}
+
+// Root returns the root object of this document. If the document is
+// synchronized in background, it returns a snapshot of the root that is not
+// affected by the changes applied later, and the changes made to the snapshot
+// are not applied to the document; use Update to update the document.
 func (d *Document) Root() *json.Object {
+	d.lock()
+	defer d.unlock()
+
 	if err := d.ensureClone(); err != nil {
 		panic(err)
 	}
 
-	ctx := change.NewContext(d.doc.changeID.Next(), "", d.cloneRoot)
-	return json.NewObject(ctx, d.cloneRoot.Object())
+	root := d.cloneRoot
+	if d.backgroundSync.Load() {
+		snapshot, err := d.cloneRoot.DeepCopy()
+		if err != nil {
+			panic(err)
+		}
+		root = snapshot
+	}
+
+	ctx := change.NewContext(d.doc.changeID.Next(), "", root)
+	return json.NewObject(ctx, root.Object())
+}
+
+// SetTreeSchema sets the schema of the tree of the given path, e.g.
+// "$.content". The schema is enforced on the local edits of the tree, and the
+// edits that violate it panic with crdt.ErrTreeSchemaViolation. The schema is
+// local to this document and is not synchronized with other replicas.
+func (d *Document) SetTreeSchema(path string, schema *crdt.TreeSchema) error {
+	d.lock()
+	defer d.unlock()
+
+	if err := d.ensureClone(); err != nil {
+		return err
+	}
+
+	for _, root := range []*crdt.Root{d.doc.root, d.cloneRoot} {
+		elem, err := root.FindByPath(path)
+		if err != nil {
+			return err
+		}
+
+		tree, ok := elem.(*crdt.Tree)
+		if !ok {
+			return fmt.Errorf("set tree schema to %T: %w", elem, crdt.ErrUnsupportedType)
+		}
+		tree.SetSchema(schema)
+	}
+
+	return nil
 }
 
 // GarbageCollect purge elements that were removed before the given time.
 func (d *Document) GarbageCollect(vector time.VersionVector) int {
+	d.lock()
+	defer d.unlock()
+
+	return d.garbageCollect(vector)
+}
+
+func (d *Document) garbageCollect(vector time.VersionVector) int {
 	if d.cloneRoot != nil {
 		if _, err := d.cloneRoot.GarbageCollect(vector); err != nil {
 			panic(err)
@@ -408,7 +857,9 @@ func (d *Document) RemoveOnlineClient(clientID string) {
 	d.doc.RemoveOnlineClient(clientID)
 }
 
-// Events returns the events of this document.
+// Events returns the events of this document. LocalChangeEvent and
+// RemoteChangeEvent are sent only if the document is created with
+// WithChangeEvents.
 func (d *Document) Events() <-chan DocEvent {
 	return d.events
 }
@@ -465,6 +916,7 @@ func (d *Document) BroadcastEventHandlers() map[string]func(
 
 func (d *Document) setInternalDoc(internalDoc *InternalDocument) {
 	d.doc = internalDoc
+	d.history.Clear()
 }
 
 func messageFromMsgAndArgs(msgAndArgs ...interface{}) string {
@@ -17,18 +17,22 @@
 package document_test
 
 import (
+	gojson "encoding/json"
 	"errors"
 	"fmt"
 	"testing"
+	gotime "time"
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/index"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -197,6 +201,40 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, 0, doc.Root().GetArray("data").Len())
 	})
 
+	t.Run("insert and set elements of array test", func(t *testing.T) {
+		docA := document.New("d1")
+		docB := document.New("d1")
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			arr := root.SetNewArray("k1").AddInteger(1, 2)
+			arr.InsertStringAfter(0, "a")
+			arr.InsertBefore(0, map[string]any{"k": "v"})
+			arr.InsertNewTextAfter(3).Edit(0, 0, "hi")
+			arr.InsertNewCounterAfter(4, crdt.IntegerCnt, 5).Increase(1)
+			arr.InsertNewArrayAfter(0, []int{7, 8})
+			assert.Equal(t, `[{"k":"v"},[7,8],1,"a",2,[{"val":"hi"}],6]`, arr.Marshal())
+
+			arr.SetNewObject(2).SetBool("b", true)
+			arr.SetString(3, "b")
+			arr.Set(4, []any{nil, 1.5})
+			arr.SetNewTree(6, &json.TreeNode{
+				Type:     "doc",
+				Children: []json.TreeNode{{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "ab"}}}},
+			})
+			assert.Panics(t, func() { arr.InsertAfter(7, 1) })
+			assert.Panics(t, func() { arr.Set(7, 1) })
+			assert.Panics(t, func() { arr.InsertNewObjectAfter(0, 1) })
+			return nil
+		}))
+		expected := `{"k1":[{"k":"v"},[7,8],{"b":true},"b",[null,1.500000],[{"val":"hi"}],` +
+			`{"type":"doc","children":[{"type":"p","children":[{"type":"text","value":"ab"}]}]}]}`
+		assert.Equal(t, expected, docA.Marshal())
+
+		assert.NoError(t, docB.ApplyChangePack(encodeAndDecode(t, docA.CreateChangePack())))
+		assert.Equal(t, expected, docB.Marshal())
+		assert.Equal(t, "<doc><p>ab</p></doc>", docB.Root().GetArray("k1").GetTree(6).ToXML())
+	})
+
 	t.Run("text test", func(t *testing.T) {
 		doc := document.New("d1")
 
@@ -349,6 +387,25 @@ func TestDocument(t *testing.T) {
 				`{"attrs":{"list":"true"},"val":"\n"},{"val":" Yorkie"}]}`,
 			doc.Marshal(),
 		)
+
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			text := root.GetText("k1")
+			text.RemoveStyle(0, 5, []string{"b"})
+			assert.Equal(
+				t,
+				`[0:0:00:0 {} ""][1:2:00:0 {} "Hel"][1:2:00:3 {"i":"1"} "lo"]`+
+					`[5:1:00:0 {"list":"true"} "\n"][4:1:00:0 {} " Yorkie"]{1:2:00:5 {} " world"}`,
+				text.ToTestString(),
+			)
+			return nil
+		})
+		assert.NoError(t, err)
+		assert.Equal(
+			t,
+			`{"k1":[{"val":"Hel"},{"attrs":{"i":"1"},"val":"lo"},`+
+				`{"attrs":{"list":"true"},"val":"\n"},{"val":" Yorkie"}]}`,
+			doc.Marshal(),
+		)
 	})
 
 	t.Run("counter test", func(t *testing.T) {
@@ -590,4 +647,1173 @@ func TestDocument(t *testing.T) {
 		packB = docB.CreateChangePack()
 		assert.False(t, packA.Changes[2].AfterOrEqual(packB.Changes[1]))
 	})
+
+	t.Run("subscribe path test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1")
+		docA.SetActor(actorA)
+		docB := document.New("d1")
+		docB.SetActor(actorB)
+
+		_, err = docB.Subscribe("todos", func(e document.DocEvent) {})
+		assert.ErrorIs(t, err, crdt.ErrInvalidPath)
+
+		var todoEvents, titleEvents []document.DocEvent
+		_, err = docB.Subscribe("$.todos", func(e document.DocEvent) {
+			todoEvents = append(todoEvents, e)
+		})
+		assert.NoError(t, err)
+		unsubscribe, err := docB.Subscribe("$.todos.1.0", func(e document.DocEvent) {
+			titleEvents = append(titleEvents, e)
+		})
+		assert.NoError(t, err)
+
+		// 01. Remote changes applied to docB.
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			todos := root.SetNewArray("todos")
+			todos.AddNewArray().AddString("a")
+			todos.AddNewArray().AddString("b")
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		packA := docA.CreateChangePack()
+		packA.VersionVector.Set(docB.ActorID(), docB.VersionVector().VersionOf(docB.ActorID()))
+		assert.NoError(t, docB.ApplyChangePack(packA))
+		assert.Len(t, todoEvents, 1)
+		assert.Equal(t, document.RemoteChangeEvent, todoEvents[0].Type)
+		assert.Len(t, todoEvents[0].Change.Operations, 5)
+		assert.Len(t, titleEvents, 1)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationSet, Path: "$", Key: "todos"},
+			{Type: document.OperationAdd, Path: "$.todos", Index: 1},
+			{Type: document.OperationAdd, Path: "$.todos.1", Index: 0},
+		}, titleEvents[0].Change.Operations)
+
+		// 02. Local changes that touch only the first todo or other members.
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("todos").GetArray(0).AddString("c")
+			root.SetString("k1", "v2")
+			return nil
+		}))
+		assert.Len(t, todoEvents, 2)
+		assert.Equal(t, document.LocalChangeEvent, todoEvents[1].Type)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationAdd, Path: "$.todos.0", Index: 1},
+		}, todoEvents[1].Change.Operations)
+		assert.Len(t, titleEvents, 1)
+
+		// 03. Unsubscribed callback is no longer called.
+		unsubscribe()
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("todos").GetArray(1).Delete(0)
+			return nil
+		}))
+		assert.Len(t, todoEvents, 3)
+		assert.Len(t, titleEvents, 1)
+	})
+
+	t.Run("path accessors test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("a").SetNewArray("b").AddInteger(0, 1).AddNewArray().AddString("c")
+			root.GetObject("a").SetNewText("t").Edit(0, 0, "hello")
+			return nil
+		}))
+		assert.Equal(t, `{"a":{"b":[0,1,["c"]],"t":[{"val":"hello"}]}}`, doc.Marshal())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			assert.Equal(t, "1", root.GetByPath("$.a.b[1]").Marshal())
+			assert.Equal(t, `"c"`, root.GetByPath("$.a.b[2][0]").Marshal())
+			assert.Equal(t, `"c"`, root.GetByPath("$.a.b.2.0").Marshal())
+			assert.Nil(t, root.GetByPath("$.a.b[3]"))
+			assert.Nil(t, root.GetByPath("$.a.x.y"))
+			assert.Equal(t, 3, root.GetArrayByPath("$.a.b").Len())
+			assert.Panics(t, func() { root.GetTextByPath("$.a.b") })
+
+			root.GetTextByPath("$.a.t").Edit(0, 1, "H")
+			root.SetByPath("$.a.b[0]", "zero")
+			root.SetByPath("$.a.c", map[string]any{"d": 1})
+			root.SetByPath("$.a.b[2][0]", 3.5)
+			assert.Equal(t, int32(1), root.DeleteByPath("$.a.b[1]").(*crdt.Primitive).Value())
+			assert.Nil(t, root.DeleteByPath("$.a.x"))
+
+			assert.Panics(t, func() { root.SetByPath("$", 1) })
+			assert.Panics(t, func() { root.SetByPath("$.x.y", 1) })
+			assert.Panics(t, func() { root.SetByPath("$.a.b[5]", 1) })
+			return nil
+		}))
+		assert.Equal(t, `{"a":{"b":["zero",[3.500000]],"c":{"d":1},"t":[{"val":"H"},{"val":"ello"}]}}`, doc.Marshal())
+	})
+
+	t.Run("text read test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			text := root.SetNewText("k1")
+			text.Edit(0, 0, "Hello world")
+			text.Edit(5, 5, ",")
+			text.Style(0, 5, map[string]string{"b": "1"})
+			text.Edit(6, 12, " 😀", map[string]string{"b": "1"})
+			return nil
+		}))
+
+		text := doc.Root().GetText("k1")
+		assert.Equal(t, "Hello, 😀", text.String())
+		assert.Equal(t, 9, text.Len())
+		assert.Equal(t, []json.TextRun{
+			{Value: "Hello", Attributes: map[string]string{"b": "1"}},
+			{Value: ","},
+			{Value: " 😀", Attributes: map[string]string{"b": "1"}},
+		}, text.Runs())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Edit(5, 6, "")
+			return nil
+		}))
+		assert.Equal(t, []json.TextRun{
+			{Value: "Hello 😀", Attributes: map[string]string{"b": "1"}},
+		}, doc.Root().GetText("k1").Runs())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			assert.Nil(t, root.SetNewText("k2").Runs())
+			return nil
+		}))
+	})
+
+	t.Run("tree read test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:       "p",
+					Attributes: map[string]string{"align": "left"},
+					Children:   []json.TreeNode{{Type: "text", Value: "ab"}},
+				}, {
+					Type: "ul",
+					Children: []json.TreeNode{{
+						Type:     "li",
+						Children: []json.TreeNode{{Type: "text", Value: "cd"}},
+					}},
+				}},
+			})
+			root.GetTree("t").Edit(2, 2, &json.TreeNode{Type: "text", Value: "X"}, 0)
+			return nil
+		}))
+		tree := doc.Root().GetTree("t")
+		assert.Equal(t, `<doc><p align="left">aXb</p><ul><li>cd</li></ul></doc>`, tree.ToXML())
+
+		// 01. Read the nodes by paths.
+		assert.Equal(t, "doc", tree.Node(nil).Type)
+		assert.Equal(t, json.TreeNode{
+			Type:       "p",
+			Attributes: map[string]string{"align": "left"},
+			Children:   []json.TreeNode{{Type: "text", Value: "aXb"}},
+		}, tree.Node([]int{0}))
+		assert.Equal(t, json.TreeNode{Type: "text", Value: "aXb"}, tree.Node([]int{0, 0}))
+		assert.Len(t, tree.Children(nil), 2)
+		assert.Equal(t, []json.TreeNode{{Type: "text", Value: "cd"}}, tree.Children([]int{1, 0}))
+		assert.Equal(t, map[string]string{"align": "left"}, tree.Attributes([]int{0}))
+		assert.Nil(t, tree.Attributes([]int{1}))
+		assert.PanicsWithValue(t, index.ErrUnreachablePath, func() { tree.Node([]int{2}) })
+		assert.PanicsWithValue(t, index.ErrUnreachablePath, func() { tree.Node([]int{0, 0, 0}) })
+
+		// 02. Encode the tree to JSON.
+		assert.Equal(t, doc.Root().Get("t").Marshal(), tree.ToJSON())
+
+		// 03. Traverse the nodes with their indexes and paths.
+		type visit struct {
+			nodeType string
+			idx      int
+			path     []int
+		}
+		var visits []visit
+		tree.Traverse(func(node json.TreeNode, idx int, path []int) {
+			visits = append(visits, visit{node.Type, idx, path})
+			assert.Equal(t, node, tree.Node(path))
+		})
+		assert.Equal(t, []visit{
+			{"p", 0, []int{0}},
+			{"text", 1, []int{0, 0}},
+			{"ul", 5, []int{1}},
+			{"li", 6, []int{1, 0}},
+			{"text", 7, []int{1, 0, 0}},
+		}, visits)
+	})
+
+	t.Run("text delta test", func(t *testing.T) {
+		docA := document.New("d1")
+		docB := document.New("d1")
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").ApplyDelta([]json.DeltaOp{
+				{Insert: "Hello", Attributes: map[string]any{"bold": true}},
+				{Insert: " world\n"},
+			})
+			return nil
+		}))
+		assert.Equal(t, []json.DeltaOp{
+			{Insert: "Hello", Attributes: map[string]any{"bold": "true"}},
+			{Insert: " world\n"},
+		}, docA.Root().GetText("k1").ToDelta())
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").ApplyDelta([]json.DeltaOp{
+				{Retain: 2, Attributes: map[string]any{"bold": nil, "italic": "1"}},
+				{Delete: 3},
+				{Insert: "y"},
+				{Retain: 1, Attributes: map[string]any{"header": 1}},
+			})
+			return nil
+		}))
+		assert.NoError(t, docB.ApplyChangePack(encodeAndDecode(t, docA.CreateChangePack())))
+		assert.Equal(t, []json.DeltaOp{
+			{Insert: "He", Attributes: map[string]any{"italic": "1"}},
+			{Insert: "y"},
+			{Insert: " ", Attributes: map[string]any{"header": "1"}},
+			{Insert: "world\n"},
+		}, docB.Root().GetText("k1").ToDelta())
+		assert.Equal(t, docA.Marshal(), docB.Marshal())
+	})
+
+	t.Run("tree export test", func(t *testing.T) {
+		var pmDoc json.ProseMirrorNode
+		assert.NoError(t, gojson.Unmarshal([]byte(`{"type":"doc","content":[
+			{"type":"heading","attrs":{"level":2},"content":[{"type":"text","text":"Title"}]},
+			{"type":"paragraph","content":[
+				{"type":"text","text":"a<b "},
+				{"type":"text","text":"bold","marks":[{"type":"strong"}]},
+				{"type":"text","text":" link","marks":[{"type":"link","attrs":{"href":"https://yorkie.dev"}}]}
+			]},
+			{"type":"bullet_list","content":[
+				{"type":"list_item","content":[{"type":"paragraph","content":[{"type":"text","text":"one"}]}]},
+				{"type":"list_item","content":[{"type":"paragraph","content":[{"type":"text","text":"two"}]}]}
+			]}
+		]}`), &pmDoc))
+		root, err := json.TreeNodeFromProseMirror(pmDoc)
+		assert.NoError(t, err)
+
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetNewTree("t", root)
+			return nil
+		}))
+		tree := doc.Root().GetTree("t")
+
+		assert.Equal(t, `<heading level="2">Title</heading>`+
+			`<paragraph>a&lt;b <strong>bold</strong><link href="https://yorkie.dev"> link</link></paragraph>`+
+			`<bullet_list><list_item><paragraph>one</paragraph></list_item>`+
+			`<list_item><paragraph>two</paragraph></list_item></bullet_list>`, tree.ToHTML())
+		assert.Equal(t, "## Title\n\n"+
+			"a<b **bold**[ link](https://yorkie.dev)\n\n"+
+			"- one\n- two", tree.ToMarkdown())
+
+		exported, err := gojson.Marshal(tree.ToProseMirror("strong", "link"))
+		assert.NoError(t, err)
+		var roundTrip json.ProseMirrorNode
+		assert.NoError(t, gojson.Unmarshal(exported, &roundTrip))
+		converted, err := json.TreeNodeFromProseMirror(roundTrip)
+		assert.NoError(t, err)
+		assert.Equal(t, *root, *converted)
+
+		_, err = json.TreeNodeFromProseMirror(json.ProseMirrorNode{Type: "text", Text: "a"})
+		assert.ErrorIs(t, err, json.ErrInvalidProseMirrorNode)
+		_, err = json.TreeNodeFromProseMirror(json.ProseMirrorNode{
+			Type:    "doc",
+			Content: []json.ProseMirrorNode{{Type: "text"}},
+		})
+		assert.ErrorIs(t, err, json.ErrEmptyTextNode)
+	})
+
+	t.Run("tree schema test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetNewTree("t", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:     "p",
+					Children: []json.TreeNode{{Type: "text", Value: "ab"}},
+				}},
+			})
+			return nil
+		}))
+
+		assert.NoError(t, doc.SetTreeSchema("$.t", &crdt.TreeSchema{
+			Root: "doc",
+			Nodes: map[string]crdt.TreeNodeRule{
+				"doc": {Children: []string{"p"}},
+				"p":   {Children: []string{"text"}, Attributes: []string{"align"}},
+			},
+		}))
+		assert.ErrorIs(t, doc.SetTreeSchema("$.x", nil), crdt.ErrChildNotFound)
+
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			tree := r.GetTree("t")
+			tree.Edit(4, 4, &json.TreeNode{
+				Type:     "p",
+				Children: []json.TreeNode{{Type: "text", Value: "cd"}},
+			}, 0)
+			tree.Edit(2, 2, nil, 1)
+			tree.Style(0, 1, map[string]string{"align": "center"})
+			return nil
+		}))
+		assert.Equal(t, `<doc><p align="center">a</p><p>b</p><p>cd</p></doc>`, doc.Root().GetTree("t").ToXML())
+
+		// text directly under doc
+		assert.PanicsWithError(t, `text under doc: `+crdt.ErrTreeSchemaViolation.Error(), func() {
+			_ = doc.Update(func(r *json.Object, p *presence.Presence) error {
+				r.GetTree("t").Edit(0, 0, &json.TreeNode{Type: "text", Value: "x"}, 0)
+				return nil
+			})
+		})
+
+		// unknown node type
+		assert.Panics(t, func() {
+			_ = doc.Update(func(r *json.Object, p *presence.Presence) error {
+				r.GetTree("t").EditByPath([]int{0, 1}, []int{0, 1}, &json.TreeNode{
+					Type:     "b",
+					Children: []json.TreeNode{{Type: "text", Value: "x"}},
+				}, 0)
+				return nil
+			})
+		})
+
+		// attribute that is not allowed
+		assert.Panics(t, func() {
+			_ = doc.Update(func(r *json.Object, p *presence.Presence) error {
+				r.GetTree("t").Style(0, 1, map[string]string{"color": "red"})
+				return nil
+			})
+		})
+
+		// the tree is not changed by the violating edits
+		assert.Equal(t, `<doc><p align="center">a</p><p>b</p><p>cd</p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.GetTree("t").EditBulk(0, 3, []*json.TreeNode{{
+				Type:     "p",
+				Children: []json.TreeNode{{Type: "text", Value: "e"}},
+			}}, 0)
+			return nil
+		}))
+		assert.Equal(t, `<doc><p>e</p><p>b</p><p>cd</p></doc>`, doc.Root().GetTree("t").ToXML())
+	})
+
+	t.Run("diff test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+
+		doc := document.New("d1")
+		doc.SetActor(actorA)
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetInteger("a", 1)
+			r.SetString("b", "x")
+			r.SetNewArray("list").AddInteger(1, 2, 3)
+			r.SetNewText("text").Edit(0, 0, "hello")
+			r.SetNewCounter("cnt", crdt.IntegerCnt, 0)
+			r.SetNewTree("tree", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:     "p",
+					Children: []json.TreeNode{{Type: "text", Value: "ab"}},
+				}},
+			})
+			return nil
+		}))
+		from, err := doc.InternalDocument().Root().DeepCopy()
+		assert.NoError(t, err)
+
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetInteger("a", 2)
+			r.Delete("b")
+			r.SetBool("c", true)
+			r.GetArray("list").MoveAfterByIndex(2, 0)
+			r.GetText("text").Edit(1, 3, "EY")
+			r.GetText("text").Style(0, 1, map[string]string{"bold": "true"})
+			r.GetCounter("cnt").Increase(5)
+			r.GetTree("tree").Edit(4, 4, &json.TreeNode{
+				Type:     "p",
+				Children: []json.TreeNode{{Type: "text", Value: "cd"}},
+			}, 0)
+			r.GetTree("tree").Edit(2, 3, nil, 0)
+			return nil
+		}))
+
+		author := actorA.String()
+		diffs, err := crdt.Diff(from, doc.InternalDocument().Root())
+		assert.NoError(t, err)
+		assert.Equal(t, []*crdt.Difference{
+			{Type: crdt.DiffChange, Path: "$.a", From: "1", To: "2", Author: author},
+			{Type: crdt.DiffRemove, Path: "$.b", From: `"x"`, Author: author},
+			{Type: crdt.DiffAdd, Path: "$.c", To: "true", Author: author},
+			{Type: crdt.DiffChange, Path: "$.cnt", From: "0", To: "5"},
+			{Type: crdt.DiffMove, Path: "$.list", FromIndex: 0, ToIndex: 2, Author: author},
+			{Type: crdt.DiffDelete, Path: "$.text", Index: 1, Content: "el", Author: author},
+			{Type: crdt.DiffInsert, Path: "$.text", Index: 1, Content: "EY", Author: author},
+			{Type: crdt.DiffStyle, Path: "$.text", Index: 0, Content: "h", To: `{"bold":"true"}`},
+			{Type: crdt.DiffInsert, Path: "$.tree", Index: 3, Content: "<p>cd</p>", Author: author},
+			{Type: crdt.DiffDelete, Path: "$.tree", Index: 2, Content: "b", Author: author},
+		}, diffs)
+
+		// the same roots have no differences
+		diffs, err = crdt.Diff(from, from)
+		assert.NoError(t, err)
+		assert.Empty(t, diffs)
+	})
+
+	t.Run("restore test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1")
+		docA.SetActor(actorA)
+		docB := document.New("d1")
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetString("k1", "v1")
+			r.SetNewObject("k2").SetInteger("a", 1)
+			r.SetNewArray("k3").AddInteger(1, 2, 3)
+			r.SetNewText("k4").Edit(0, 0, "hello")
+			r.SetNewCounter("k5", crdt.IntegerCnt, 10)
+			return nil
+		}))
+		revision, err := docA.InternalDocument().Root().DeepCopy()
+		assert.NoError(t, err)
+		expected := docA.Marshal()
+
+		assert.NoError(t, docA.Update(func(r *json.Object, p *presence.Presence) error {
+			r.Delete("k1")
+			r.GetObject("k2").SetInteger("a", 2).SetBool("b", true)
+			r.GetArray("k3").Delete(0)
+			r.GetArray("k3").MoveAfterByIndex(1, 0)
+			r.GetArray("k3").AddInteger(4)
+			r.GetText("k4").Edit(0, 5, "world")
+			r.GetCounter("k5").Increase(5)
+			r.SetString("k6", "v6")
+			return nil
+		}))
+		assert.NotEqual(t, expected, docA.Marshal())
+
+		// restore the root of the revision as a new change
+		assert.NoError(t, docA.Restore(revision, "restore"))
+		assert.Equal(t, expected, docA.Marshal())
+
+		// restoring the same root again makes no change
+		pack := docA.CreateChangePack()
+		assert.NoError(t, docA.Restore(revision))
+		assert.Equal(t, pack.ChangesLen(), docA.CreateChangePack().ChangesLen())
+
+		// other replicas converge to the revision by applying the changes
+		assert.NoError(t, docB.ApplyChangePack(encodeAndDecode(t, pack)))
+		assert.Equal(t, expected, docB.Marshal())
+	})
+
+	t.Run("local state test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1")
+		docA.SetActor(actorA)
+		docB := document.New("d1")
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetNewArray("k1").AddInteger(1, 2)
+			r.SetNewText("k2").Edit(0, 0, "hello")
+			return nil
+		}))
+		assert.NoError(t, docA.Update(func(r *json.Object, p *presence.Presence) error {
+			r.GetArray("k1").Delete(0)
+			r.GetText("k2").Edit(0, 1, "H")
+			return nil
+		}))
+
+		// restore the state including the local changes to a new document
+		state, err := docA.LocalState()
+		assert.NoError(t, err)
+		restored := document.New("d1")
+		assert.NoError(t, restored.ApplyLocalState(state))
+		assert.Equal(t, docA.Marshal(), restored.Marshal())
+		assert.Equal(t, actorA, restored.ActorID())
+		assert.Equal(t, docA.CreateChangePack().Checkpoint, restored.CreateChangePack().Checkpoint)
+		assert.Equal(t, 2, restored.CreateChangePack().ChangesLen())
+
+		// the local changes of the state are not affected by the document
+		assert.NoError(t, docA.Update(func(r *json.Object, p *presence.Presence) error {
+			r.GetArray("k1").AddInteger(4)
+			return nil
+		}))
+		assert.Len(t, state.LocalChanges, 2)
+
+		// the restored document continues the local changes
+		assert.NoError(t, restored.Update(func(r *json.Object, p *presence.Presence) error {
+			r.GetArray("k1").AddInteger(3)
+			return nil
+		}))
+		pack := restored.CreateChangePack()
+		assert.Equal(t, 3, pack.ChangesLen())
+		assert.Equal(t, uint32(3), pack.Changes[2].ClientSeq())
+
+		assert.NoError(t, docB.ApplyChangePack(encodeAndDecode(t, pack)))
+		assert.Equal(t, `{"k1":[2,3],"k2":[{"val":"H"},{"val":"ello"}]}`, docB.Marshal())
+		assert.Equal(t, restored.Marshal(), docB.Marshal())
+	})
+
+	t.Run("blame test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		doc := document.New("d1")
+		doc.SetActor(actorA)
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetInteger("a", 1)
+			r.SetNewArray("list").AddInteger(1, 2)
+			r.SetNewText("text").Edit(0, 0, "hello")
+			r.SetNewTree("tree", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:     "p",
+					Children: []json.TreeNode{{Type: "text", Value: "ab"}},
+				}},
+			})
+			return nil
+		}))
+
+		doc.SetActor(actorB)
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetInteger("a", 2)
+			r.GetText("text").Edit(1, 3, "EY")
+			r.GetTree("tree").Edit(2, 2, &json.TreeNode{Type: "text", Value: "c"}, 0)
+			return nil
+		}))
+
+		a, b := actorA.String(), actorB.String()
+		assert.Equal(t, []*crdt.Attribution{
+			{Path: "$.a", Author: b, Lamport: 2},
+			{Path: "$.list", Author: a, Lamport: 1},
+			{Path: "$.list.0", Author: a, Lamport: 1},
+			{Path: "$.list.1", Author: a, Lamport: 1},
+			{Path: "$.text", Author: a, Lamport: 1},
+			{Path: "$.text", Index: 0, Content: "h", Author: a, Lamport: 1},
+			{Path: "$.text", Index: 1, Content: "EY", Author: b, Lamport: 2},
+			{Path: "$.text", Index: 3, Content: "lo", Author: a, Lamport: 1},
+			{Path: "$.tree", Author: a, Lamport: 1},
+			{Path: "$.tree", Index: 1, Content: "a", Author: a, Lamport: 1},
+			{Path: "$.tree", Index: 2, Content: "c", Author: b, Lamport: 2},
+			{Path: "$.tree", Index: 3, Content: "b", Author: a, Lamport: 1},
+		}, crdt.Blame(doc.InternalDocument().Root()))
+	})
+
+	t.Run("decode test", func(t *testing.T) {
+		type Todo struct {
+			Text string `yorkie:"text"`
+			Done bool   `yorkie:"done"`
+		}
+		type Root struct {
+			Title   string            `yorkie:"title"`
+			Todos   []Todo            `yorkie:"todos"`
+			Count   int               `yorkie:"count"`
+			Tags    map[string]string `yorkie:"tags"`
+			Content string            `yorkie:"content"`
+			Due     *gotime.Time      `yorkie:"due"`
+			Skip    string            `yorkie:"-"`
+		}
+
+		due := gotime.Date(2025, 1, 1, 0, 0, 0, 0, gotime.UTC)
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			r.SetString("title", "todos")
+			r.SetNewArray("todos").AddNewObject(Todo{Text: "a", Done: true})
+			r.SetNewCounter("count", crdt.IntegerCnt, 3)
+			r.SetNewObject("tags", map[string]any{"k": "v"})
+			r.SetNewText("content").Edit(0, 0, "hello")
+			r.SetDate("due", due)
+			r.SetString("-", "skipped")
+			r.SetString("unknown", "ignored")
+			return nil
+		}))
+
+		var root Root
+		assert.NoError(t, doc.Root().Decode(&root))
+		assert.Equal(t, Root{
+			Title:   "todos",
+			Todos:   []Todo{{Text: "a", Done: true}},
+			Count:   3,
+			Tags:    map[string]string{"k": "v"},
+			Content: "hello",
+			Due:     &due,
+		}, root)
+
+		// the counter is increased and the text is edited in the changed range
+		assert.NoError(t, doc.Update(func(r *json.Object, p *presence.Presence) error {
+			root.Count = 5
+			root.Content = "help"
+			r.Assign(root)
+			return nil
+		}))
+		assert.Equal(t, "5", doc.Root().GetCounter("count").Marshal())
+		assert.Equal(t, `[{"val":"hel"},{"val":"p"}]`, doc.Root().GetText("content").Marshal())
+
+		var generic map[string]any
+		assert.NoError(t, doc.Root().Decode(&generic))
+		assert.Equal(t, "todos", generic["title"])
+		assert.Equal(t, int32(5), generic["count"])
+
+		// decode into the mismatched type or the invalid target
+		var mismatched struct {
+			Title int `yorkie:"title"`
+		}
+		assert.ErrorIs(t, doc.Root().Decode(&mismatched), json.ErrDecodeTypeMismatch)
+		assert.ErrorIs(t, doc.Root().Decode(root), json.ErrInvalidDecodeTarget)
+	})
+
+	t.Run("typed test", func(t *testing.T) {
+		type Todo struct {
+			Text string `yorkie:"text"`
+			Done bool   `yorkie:"done"`
+		}
+		type Root struct {
+			Title string `yorkie:"title"`
+			Todos []Todo `yorkie:"todos"`
+			Note  string `yorkie:"note,omitEmpty"`
+		}
+
+		doc := document.New("d1")
+		typed := document.NewTyped[Root](doc)
+		assert.NoError(t, typed.Update(func(r *Root) {
+			r.Title = "todos"
+			r.Todos = []Todo{{Text: "a"}, {Text: "b"}}
+			r.Note = "note"
+		}))
+		assert.Equal(t, `{"note":"note","title":"todos","todos":[{"done":false,"text":"a"},{"done":false,"text":"b"}]}`,
+			doc.Marshal())
+		v, err := typed.Get()
+		assert.NoError(t, err)
+		assert.Equal(t, Root{
+			Title: "todos",
+			Todos: []Todo{{Text: "a"}, {Text: "b"}},
+			Note:  "note",
+		}, v)
+
+		// only the changed members are updated
+		assert.NoError(t, typed.Update(func(r *Root) {
+			r.Todos[1].Done = true
+			r.Todos = append(r.Todos, Todo{Text: "c"})
+			r.Note = ""
+		}))
+		assert.Equal(
+			t,
+			`{"title":"todos","todos":[{"done":false,"text":"a"},{"done":true,"text":"b"},{"done":false,"text":"c"}]}`,
+			doc.Marshal(),
+		)
+
+		changes := doc.CreateChangePack().Changes
+		var types []string
+		for _, op := range changes[len(changes)-1].Operations() {
+			types = append(types, fmt.Sprintf("%T", op))
+		}
+		assert.Equal(t, []string{"*operations.Set", "*operations.Add", "*operations.Remove"}, types)
+
+		// nothing is changed if the value is not modified
+		assert.NoError(t, typed.Update(func(r *Root) {}))
+		assert.Len(t, doc.CreateChangePack().Changes, len(changes))
+
+		// the removed elements of the array are removed from the end
+		assert.NoError(t, typed.Update(func(r *Root) {
+			r.Todos = r.Todos[:1]
+		}))
+		assert.Equal(t, `{"title":"todos","todos":[{"done":false,"text":"a"}]}`, doc.Marshal())
+
+		// the root that cannot be decoded into the type returns an error
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("title", 1)
+			return nil
+		}))
+		_, err = typed.Get()
+		assert.Error(t, err)
+	})
+
+	t.Run("apply patch test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			return root.ApplyPatch([]json.PatchOperation{
+				{Op: json.PatchAdd, Path: "/todos", Value: []byte(`["a","b","c"]`)},
+				{Op: json.PatchAdd, Path: "/todos/1", Value: []byte(`"d"`)},
+				{Op: json.PatchAdd, Path: "/todos/-", Value: []byte(`"e"`)},
+				{Op: json.PatchMove, From: "/todos/0", Path: "/todos/4"},
+				{Op: json.PatchReplace, Path: "/todos/0", Value: []byte(`{"x~y":1.5}`)},
+				{Op: json.PatchCopy, From: "/todos/0", Path: "/copied"},
+				{Op: json.PatchMove, From: "/todos/3", Path: "/moved"},
+				{Op: json.PatchRemove, Path: "/todos/1"},
+				{Op: json.PatchTest, Path: "/copied/x~0y", Value: []byte(`1.5`)},
+			})
+		}))
+		assert.Equal(t, `{"copied":{"x~y":1.500000},"moved":"e","todos":[{"x~y":1.500000},"c","a"]}`, doc.Marshal())
+
+		changes := doc.CreateChangePack().Changes
+		var types []string
+		for _, op := range changes[0].Operations() {
+			types = append(types, fmt.Sprintf("%T", op))
+		}
+		assert.Equal(t, []string{
+			"*operations.Set", "*operations.Add", "*operations.Add", "*operations.Move",
+			"*operations.ArraySet", "*operations.Set", "*operations.Remove", "*operations.Set",
+			"*operations.Remove",
+		}, types)
+
+		// the applied operations are discarded if the patch fails
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text")
+			root.SetNewCounter("cnt", crdt.IntegerCnt, 0)
+			return nil
+		}))
+		for _, tc := range []struct {
+			op  json.PatchOperation
+			err error
+		}{
+			{json.PatchOperation{Op: json.PatchReplace, Path: "/text", Value: []byte(`"a"`)}, json.ErrUnsupportedPatchTarget},
+			{json.PatchOperation{Op: json.PatchAdd, Path: "/text/0", Value: []byte(`"a"`)}, json.ErrUnsupportedPatchTarget},
+			{json.PatchOperation{Op: json.PatchRemove, Path: "/cnt"}, json.ErrUnsupportedPatchTarget},
+			{json.PatchOperation{Op: json.PatchRemove, Path: "/todos/3"}, json.ErrInvalidPatch},
+			{json.PatchOperation{Op: json.PatchReplace, Path: "/none", Value: []byte(`1`)}, json.ErrInvalidPatch},
+			{json.PatchOperation{Op: json.PatchTest, Path: "/copied/x~0y", Value: []byte(`2`)}, json.ErrPatchTestFailed},
+		} {
+			err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+				return root.ApplyPatch([]json.PatchOperation{
+					{Op: json.PatchRemove, Path: "/moved"},
+					tc.op,
+				})
+			})
+			assert.ErrorIs(t, err, tc.err)
+		}
+		assert.Equal(t, `{"cnt":0,"copied":{"x~y":1.500000},"moved":"e","text":[],"todos":[{"x~y":1.500000},"c","a"]}`,
+			doc.Marshal())
+	})
+
+	t.Run("apply patches of remote changes test", func(t *testing.T) {
+		docA := document.New("d1")
+		docB := document.New("d1")
+		docC := document.New("d1")
+		docB.SubscribePatches(func(e document.DocEvent) {
+			assert.NoError(t, docC.Update(func(root *json.Object, p *presence.Presence) error {
+				return root.ApplyPatch(e.Change.Patches)
+			}))
+		})
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos").AddString("a", "b", "c", "d")
+			root.SetNewObject("a/b").SetLong("c~d", 1)
+			return nil
+		}))
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			todos := root.GetArray("todos")
+			todos.MoveBefore(todos.Get(0).CreatedAt(), todos.Get(2).CreatedAt())
+			todos.MoveAfterByIndex(3, 0)
+			todos.Delete(1)
+			todos.Set(0, "C")
+			root.GetObject("a/b").SetBool("e", true)
+			return nil
+		}))
+
+		packA := docA.CreateChangePack()
+		packA.VersionVector.Set(docB.ActorID(), docB.VersionVector().VersionOf(docB.ActorID()))
+		assert.NoError(t, docB.ApplyChangePack(packA))
+		assert.Equal(t, docA.Marshal(), docC.Marshal())
+	})
+
+	t.Run("apply merge patch test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "yorkie")
+			root.SetNewObject("author").SetString("name", "a").SetString("email", "a@b.c")
+			root.SetNewArray("tags").AddString("x", "y")
+			root.SetNewText("text")
+			return nil
+		}))
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			return root.ApplyMergePatch([]byte(
+				`{"title":"hello","author":{"email":null,"age":3},"tags":["z"],"info":{"a":null,"b":1}}`,
+			))
+		}))
+		assert.Equal(t, `{"author":{"age":3,"name":"a"},"info":{"b":1},"tags":["z"],"text":[],"title":"hello"}`,
+			doc.Marshal())
+
+		err := doc.Update(func(root *json.Object, p *presence.Presence) error {
+			return root.ApplyMergePatch([]byte(`{"text":"a"}`))
+		})
+		assert.ErrorIs(t, err, json.ErrUnsupportedPatchTarget)
+
+		err = doc.Update(func(root *json.Object, p *presence.Presence) error {
+			return root.ApplyMergePatch([]byte(`[1]`))
+		})
+		assert.ErrorIs(t, err, json.ErrInvalidPatch)
+	})
+
+	t.Run("text position test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1")
+		docA.SetActor(actorA)
+		docB := document.New("d1")
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "Hello world")
+			return nil
+		}))
+		assert.NoError(t, docB.ApplyChangePack(encodeAndDecode(t, docA.CreateChangePack())))
+
+		// 01. Store the position of the cursor in front of "world" in the
+		// presence of B.
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			pos, err := root.GetText("k1").IndexToPos(6)
+			assert.NoError(t, err)
+			encoded, err := gojson.Marshal(pos.ToStruct())
+			assert.NoError(t, err)
+			p.Set("cursor", string(encoded))
+			return nil
+		}))
+
+		// 02. Resolve the position after the remote change of A.
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Edit(0, 5, "Hi,")
+			return nil
+		}))
+		pack := encodeAndDecode(t, docA.CreateChangePack())
+		pack.Changes = pack.Changes[1:]
+		assert.NoError(t, docB.ApplyChangePack(pack))
+		assert.Equal(t, "Hi, world", docB.Root().GetText("k1").String())
+
+		var decoded crdt.RGATreeSplitNodePosStruct
+		assert.NoError(t, gojson.Unmarshal([]byte(docB.PresenceForTest(actorB.String())["cursor"]), &decoded))
+		pos, err := crdt.RGATreeSplitNodePosFromStruct(decoded)
+		assert.NoError(t, err)
+		idx, err := docB.Root().GetText("k1").PosToIndex(pos)
+		assert.NoError(t, err)
+		assert.Equal(t, 4, idx)
+	})
+
+	t.Run("tree position test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1")
+		docA.SetActor(actorA)
+		docB := document.New("d1")
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "ab"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "cd"}}},
+				},
+			})
+			return nil
+		}))
+		assert.NoError(t, docB.ApplyChangePack(encodeAndDecode(t, docA.CreateChangePack())))
+
+		// 01. Store the position between "c" and "d" in the presence of B.
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			pos, err := root.GetTree("t").PathToPos([]int{1, 1})
+			assert.NoError(t, err)
+			path, err := root.GetTree("t").PosToPath(pos)
+			assert.NoError(t, err)
+			assert.Equal(t, []int{1, 1}, path)
+
+			encoded, err := gojson.Marshal(pos.ToStruct())
+			assert.NoError(t, err)
+			p.Set("cursor", string(encoded))
+			return nil
+		}))
+
+		// 02. Resolve the position after the remote change of A.
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(0, 0, &json.TreeNode{
+				Type:     "p",
+				Children: []json.TreeNode{{Type: "text", Value: "xy"}},
+			}, 0)
+			root.GetTree("t").Edit(9, 9, &json.TreeNode{Type: "text", Value: "z"}, 0)
+			return nil
+		}))
+		pack := encodeAndDecode(t, docA.CreateChangePack())
+		pack.Changes = pack.Changes[1:]
+		assert.NoError(t, docB.ApplyChangePack(pack))
+		assert.Equal(t, "<doc><p>xy</p><p>ab</p><p>zcd</p></doc>", docB.Root().GetTree("t").ToXML())
+
+		var decoded crdt.TreePosStruct
+		assert.NoError(t, gojson.Unmarshal([]byte(docB.PresenceForTest(actorB.String())["cursor"]), &decoded))
+		pos, err := crdt.TreePosFromStruct(decoded)
+		assert.NoError(t, err)
+		path, err := docB.Root().GetTree("t").PosToPath(pos)
+		assert.NoError(t, err)
+		assert.Equal(t, []int{2, 2}, path)
+		idx, err := docB.Root().GetTree("t").PosToIndex(pos)
+		assert.NoError(t, err)
+		assert.Equal(t, 11, idx)
+	})
+
+	t.Run("tree move test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+				},
+			})
+			return nil
+		}))
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{3})
+			return nil
+		}))
+		assert.Equal(t, "<doc><p>b</p><p>c</p><p>a</p></doc>", doc.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{2}, []int{0, 1})
+			return nil
+		}))
+		assert.Equal(t, "<doc><p>b<p>a</p></p><p>c</p></doc>", doc.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			assert.Panics(t, func() { root.GetTree("t").Move([]int{0}, []int{0, 1, 0}) })
+			assert.Panics(t, func() { root.GetTree("t").Move([]int{0, 0}, []int{1}) })
+			assert.Panics(t, func() { root.GetTree("t").Move([]int{3}, []int{0}) })
+			return nil
+		}))
+	})
+
+	t.Run("concurrent tree move test", func(t *testing.T) {
+		initial := &json.TreeNode{
+			Type: "doc",
+			Children: []json.TreeNode{
+				{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+				{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+				{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+			},
+		}
+
+		tests := []struct {
+			desc     string
+			updateA  func(tree *json.Tree)
+			updateB  func(tree *json.Tree)
+			expected string
+		}{{
+			desc:     "move the same node to the different positions",
+			updateA:  func(tree *json.Tree) { tree.Move([]int{0}, []int{2}) },
+			updateB:  func(tree *json.Tree) { tree.Move([]int{0}, []int{3}) },
+			expected: "<doc><p>b</p><p>c</p><p>a</p></doc>",
+		}, {
+			desc:     "move the same node to the same position",
+			updateA:  func(tree *json.Tree) { tree.Move([]int{0}, []int{3}) },
+			updateB:  func(tree *json.Tree) { tree.Move([]int{0}, []int{3}) },
+			expected: "<doc><p>b</p><p>c</p><p>a</p></doc>",
+		}, {
+			desc:     "move the same node to the different parents",
+			updateA:  func(tree *json.Tree) { tree.Move([]int{0}, []int{1, 1}) },
+			updateB:  func(tree *json.Tree) { tree.Move([]int{0}, []int{2, 1}) },
+			expected: "<doc><p>b</p><p>c<p>a</p></p></doc>",
+		}, {
+			desc:     "move the different nodes",
+			updateA:  func(tree *json.Tree) { tree.Move([]int{0}, []int{3}) },
+			updateB:  func(tree *json.Tree) { tree.Move([]int{2}, []int{0}) },
+			expected: "<doc><p>c</p><p>b</p><p>a</p></doc>",
+		}, {
+			desc:     "move and delete the same node",
+			updateA:  func(tree *json.Tree) { tree.Move([]int{0}, []int{3}) },
+			updateB:  func(tree *json.Tree) { tree.Edit(0, 3, nil, 0) },
+			expected: "<doc><p>b</p><p>c</p></doc>",
+		}, {
+			desc:     "move and edit inside the node",
+			updateA:  func(tree *json.Tree) { tree.Move([]int{0}, []int{3}) },
+			updateB:  func(tree *json.Tree) { tree.Edit(2, 2, &json.TreeNode{Type: "text", Value: "d"}, 0) },
+			expected: "<doc><p>b</p><p>c</p><p>ad</p></doc>",
+		}, {
+			desc:     "move and delete the range across the node",
+			updateA:  func(tree *json.Tree) { tree.Move([]int{0}, []int{3}) },
+			updateB:  func(tree *json.Tree) { tree.Edit(2, 5, nil, 0) },
+			expected: "<doc><p>c</p><p>a</p></doc>",
+		}, {
+			desc:     "move and edit around the node",
+			updateA:  func(tree *json.Tree) { tree.Move([]int{0}, []int{3}) },
+			updateB:  func(tree *json.Tree) { tree.Edit(5, 5, &json.TreeNode{Type: "text", Value: "d"}, 0) },
+			expected: "<doc><p>bd</p><p>c</p><p>a</p></doc>",
+		}}
+
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		for _, tc := range tests {
+			t.Run(tc.desc, func(t *testing.T) {
+				docA := document.New("d1")
+				docA.SetActor(actorA)
+				docB := document.New("d1")
+				docB.SetActor(actorB)
+
+				assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+					root.SetNewTree("t", initial)
+					return nil
+				}))
+				packA := encodeAndDecode(t, docA.CreateChangePack())
+				assert.NoError(t, docB.ApplyChangePack(packA))
+
+				assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+					tc.updateA(root.GetTree("t"))
+					return nil
+				}))
+				assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+					tc.updateB(root.GetTree("t"))
+					return nil
+				}))
+
+				// NOTE: The first change of docA has already been applied to docB.
+				packA = encodeAndDecode(t, docA.CreateChangePack())
+				packA.Changes = packA.Changes[1:]
+				packB := encodeAndDecode(t, docB.CreateChangePack())
+				assert.NoError(t, docB.ApplyChangePack(packA))
+				assert.NoError(t, docA.ApplyChangePack(packB))
+
+				assert.Equal(t, tc.expected, docA.Root().GetTree("t").ToXML())
+				assert.Equal(t, tc.expected, docB.Root().GetTree("t").ToXML())
+			})
+		}
+	})
+
+	t.Run("reentrant lock test", func(t *testing.T) {
+		doc := document.New("d1")
+		done := make(chan struct{})
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+
+			// NOTE: The methods of the same document can be called on the
+			// goroutine holding the lock.
+			assert.Equal(t, `{"k1":"v1"}`, doc.Root().Marshal())
+			doc.GarbageCollect(helper.MaxVersionVector(doc.ActorID()))
+
+			// NOTE: The other goroutines wait until the lock is released.
+			go func() {
+				assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+					root.SetString("k2", "v2")
+					return nil
+				}))
+				close(done)
+			}()
+			select {
+			case <-done:
+				assert.Fail(t, "the other goroutine should wait for the lock")
+			case <-gotime.After(100 * gotime.Millisecond):
+			}
+			return nil
+		}))
+
+		<-done
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, doc.Marshal())
+	})
+
+	t.Run("root snapshot test", func(t *testing.T) {
+		doc := document.New("d1")
+		doc.SetBackgroundSync(true)
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+
+		snapshot := doc.Root()
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v2")
+			return nil
+		}))
+		assert.Equal(t, `{"k1":"v1"}`, snapshot.Marshal())
+
+		snapshot.SetString("k2", "v2")
+		assert.Equal(t, `{"k1":"v2"}`, doc.Marshal())
+		assert.Equal(t, `{"k1":"v2"}`, doc.Root().Marshal())
+	})
+
+	t.Run("export and import JSON test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNull("null")
+			root.SetBool("bool", true)
+			root.SetInteger("int", 1)
+			root.SetLong("long", 1<<40)
+			root.SetDouble("double", 2)
+			root.SetString("str", "1")
+			root.SetBytes("bytes", []byte("yorkie"))
+			root.SetDate("date", gotime.Date(2025, 1, 2, 3, 4, 5, 0, gotime.UTC))
+			root.SetNewCounter("cnt", crdt.LongCnt, 3)
+			root.SetNewText("text").Edit(0, 0, "ab").Style(0, 1, map[string]string{"b": "1"})
+			root.SetNewTree("tree", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:       "p",
+					Attributes: map[string]string{"align": "left"},
+					Children:   []json.TreeNode{{Type: "text", Value: "cd"}},
+				}},
+			})
+			arr := root.SetNewArray("arr").AddInteger(1).AddString("1")
+			arr.AddNewObject().SetNewCounter("cnt", crdt.IntegerCnt, 1)
+			arr.AddNewText().Edit(0, 0, "ef")
+			return nil
+		}))
+
+		exported, err := doc.ExportJSON()
+		assert.NoError(t, err)
+		imported, err := document.FromJSON("d2", exported)
+		assert.NoError(t, err)
+		assert.Equal(t, doc.Marshal(), imported.Marshal())
+
+		reexported, err := imported.ExportJSON()
+		assert.NoError(t, err)
+		assert.Equal(t, exported, reexported)
+
+		root := imported.Root()
+		assert.Equal(t, crdt.Long, root.Get("long").(*crdt.Primitive).ValueType())
+		assert.Equal(t, crdt.Double, root.Get("double").(*crdt.Primitive).ValueType())
+		assert.Equal(t, crdt.LongCnt, root.GetCounter("cnt").ValueType())
+		assert.Equal(t, crdt.IntegerCnt, root.GetArray("arr").GetObject(2).GetCounter("cnt").ValueType())
+		assert.Equal(t, "ab", root.GetText("text").String())
+		assert.Equal(t, "ef", root.GetArray("arr").GetText(3).String())
+		assert.Equal(t, `<doc><p align="left">cd</p></doc>`, root.GetTree("tree").ToXML())
+
+		_, err = document.FromJSON("d3", `{"type":"Array","value":[]}`)
+		assert.ErrorIs(t, err, document.ErrInvalidExportedJSON)
+		_, err = document.FromJSON("d3", `{"type":"Object","value":{"k":{"type":"Unknown"}}}`)
+		assert.ErrorIs(t, err, document.ErrInvalidExportedJSON)
+		_, err = document.FromJSON("d3", `{"type":"Object","value":{"k":{"type":"Integer","value":"1"}}}`)
+		assert.ErrorIs(t, err, document.ErrInvalidExportedJSON)
+	})
+}
+
+// encodeAndDecode encodes and decodes the given pack like sending it over the
+// network, because the documents should not share the version vectors of the
+// changes.
+func encodeAndDecode(t *testing.T, pack *change.Pack) *change.Pack {
+	pbPack, err := converter.ToChangePack(pack)
+	assert.NoError(t, err)
+	decoded, err := converter.FromChangePack(pbPack)
+	assert.NoError(t, err)
+	return decoded
 }
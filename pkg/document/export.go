@@ -0,0 +1,430 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+var (
+	// ErrInvalidExportedJSON is returned when the given JSON is not the one
+	// exported by ExportJSON.
+	ErrInvalidExportedJSON = errors.New("invalid exported JSON")
+)
+
+// The types of the elements in the exported JSON.
+const (
+	exportedObject         = "Object"
+	exportedArray          = "Array"
+	exportedText           = "Text"
+	exportedTree           = "Tree"
+	exportedIntegerCounter = "IntegerCounter"
+	exportedLongCounter    = "LongCounter"
+	exportedNull           = "Null"
+	exportedBoolean        = "Boolean"
+	exportedInteger        = "Integer"
+	exportedLong           = "Long"
+	exportedDouble         = "Double"
+	exportedString         = "String"
+	exportedBytes          = "Bytes"
+	exportedDate           = "Date"
+)
+
+// exportedElement is an element of the exported JSON. Every element has its
+// type with the value, e.g. {"type":"Long","value":10}, so that the types
+// that cannot be distinguished in plain JSON are preserved.
+type exportedElement struct {
+	Type  string `json:"type"`
+	Value any    `json:"value"`
+}
+
+// rawExportedElement is exportedElement whose value is not decoded yet.
+type rawExportedElement struct {
+	Type  string            `json:"type"`
+	Value gojson.RawMessage `json:"value"`
+}
+
+// exportedTextRun is a run of Text in the exported JSON.
+type exportedTextRun struct {
+	Value      string            `json:"val"`
+	Attributes map[string]string `json:"attrs,omitempty"`
+}
+
+// exportedTreeNode is a node of Tree in the exported JSON.
+type exportedTreeNode struct {
+	Type       string             `json:"type"`
+	Children   []exportedTreeNode `json:"children,omitempty"`
+	Value      string             `json:"value,omitempty"`
+	Attributes map[string]string  `json:"attributes,omitempty"`
+}
+
+// ExportJSON returns the JSON of this document whose elements are annotated
+// with their types. Unlike Marshal, the document can be rebuilt from the
+// result by FromJSON with the same types of the elements.
+func (d *Document) ExportJSON() (string, error) {
+	exported, err := exportElement(d.RootObject())
+	if err != nil {
+		return "", err
+	}
+
+	bytes, err := gojson.Marshal(exported)
+	if err != nil {
+		return "", fmt.Errorf("marshal exported JSON: %w", err)
+	}
+
+	return string(bytes), nil
+}
+
+// FromJSON creates a new document of the given key from the JSON exported by
+// ExportJSON. The contents of the document are created as a local change.
+func FromJSON(k key.Key, data string, opts ...Option) (*Document, error) {
+	var root rawExportedElement
+	if err := gojson.Unmarshal([]byte(data), &root); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidExportedJSON)
+	}
+	if root.Type != exportedObject {
+		return nil, fmt.Errorf("root must be %s: %w", exportedObject, ErrInvalidExportedJSON)
+	}
+
+	var members map[string]rawExportedElement
+	if err := decodeExportedValue(root, &members); err != nil {
+		return nil, err
+	}
+
+	doc := New(k, opts...)
+	if err := doc.Update(func(r *json.Object, p *presence.Presence) error {
+		return importMembers(r, members)
+	}, "import from JSON"); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// exportElement returns the exported element of the given element.
+func exportElement(elem crdt.Element) (*exportedElement, error) {
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		members := make(map[string]*exportedElement)
+		for k, member := range elem.Members() {
+			exported, err := exportElement(member)
+			if err != nil {
+				return nil, err
+			}
+			members[k] = exported
+		}
+		return &exportedElement{Type: exportedObject, Value: members}, nil
+	case *crdt.Array:
+		elements := make([]*exportedElement, 0, elem.Len())
+		for _, element := range elem.Elements() {
+			exported, err := exportElement(element)
+			if err != nil {
+				return nil, err
+			}
+			elements = append(elements, exported)
+		}
+		return &exportedElement{Type: exportedArray, Value: elements}, nil
+	case *crdt.Text:
+		runs := make([]exportedTextRun, 0)
+		for _, run := range json.NewText().Initialize(nil, elem).Runs() {
+			runs = append(runs, exportedTextRun{Value: run.Value, Attributes: run.Attributes})
+		}
+		return &exportedElement{Type: exportedText, Value: runs}, nil
+	case *crdt.Tree:
+		root := json.NewTree().Initialize(nil, elem).Node(nil)
+		return &exportedElement{Type: exportedTree, Value: exportTreeNode(root)}, nil
+	case *crdt.Counter:
+		switch elem.ValueType() {
+		case crdt.IntegerCnt:
+			return &exportedElement{Type: exportedIntegerCounter, Value: elem.Value()}, nil
+		case crdt.LongCnt:
+			return &exportedElement{Type: exportedLongCounter, Value: elem.Value()}, nil
+		}
+	case *crdt.Primitive:
+		switch elem.ValueType() {
+		case crdt.Null:
+			return &exportedElement{Type: exportedNull}, nil
+		case crdt.Boolean:
+			return &exportedElement{Type: exportedBoolean, Value: elem.Value()}, nil
+		case crdt.Integer:
+			return &exportedElement{Type: exportedInteger, Value: elem.Value()}, nil
+		case crdt.Long:
+			return &exportedElement{Type: exportedLong, Value: elem.Value()}, nil
+		case crdt.Double:
+			return &exportedElement{Type: exportedDouble, Value: elem.Value()}, nil
+		case crdt.String:
+			return &exportedElement{Type: exportedString, Value: elem.Value()}, nil
+		case crdt.Bytes:
+			return &exportedElement{Type: exportedBytes, Value: elem.Value()}, nil
+		case crdt.Date:
+			return &exportedElement{Type: exportedDate, Value: elem.Value()}, nil
+		}
+	}
+
+	return nil, fmt.Errorf("export %T: %w", elem, crdt.ErrUnsupportedType)
+}
+
+// exportTreeNode returns the exported node of the given tree node.
+func exportTreeNode(node json.TreeNode) exportedTreeNode {
+	exported := exportedTreeNode{
+		Type:       node.Type,
+		Value:      node.Value,
+		Attributes: node.Attributes,
+	}
+	for _, child := range node.Children {
+		exported.Children = append(exported.Children, exportTreeNode(child))
+	}
+
+	return exported
+}
+
+// importTreeNode returns the tree node of the given exported node.
+func importTreeNode(exported exportedTreeNode) (json.TreeNode, error) {
+	if exported.Type == "" {
+		return json.TreeNode{}, fmt.Errorf("tree node without type: %w", ErrInvalidExportedJSON)
+	}
+	if exported.Type == "text" && (exported.Value == "" || len(exported.Children) > 0) {
+		return json.TreeNode{}, fmt.Errorf("invalid text node: %w", ErrInvalidExportedJSON)
+	}
+
+	node := json.TreeNode{
+		Type:       exported.Type,
+		Value:      exported.Value,
+		Attributes: exported.Attributes,
+	}
+	for _, child := range exported.Children {
+		imported, err := importTreeNode(child)
+		if err != nil {
+			return json.TreeNode{}, err
+		}
+		node.Children = append(node.Children, imported)
+	}
+
+	return node, nil
+}
+
+// importMembers sets the given exported members to the given object.
+func importMembers(obj *json.Object, members map[string]rawExportedElement) error {
+	for k, member := range members {
+		switch member.Type {
+		case exportedObject:
+			var children map[string]rawExportedElement
+			if err := decodeExportedValue(member, &children); err != nil {
+				return err
+			}
+			if err := importMembers(obj.SetNewObject(k), children); err != nil {
+				return err
+			}
+		case exportedArray:
+			var elements []rawExportedElement
+			if err := decodeExportedValue(member, &elements); err != nil {
+				return err
+			}
+			if err := importElements(obj.SetNewArray(k), elements); err != nil {
+				return err
+			}
+		case exportedText:
+			var runs []exportedTextRun
+			if err := decodeExportedValue(member, &runs); err != nil {
+				return err
+			}
+			importTextRuns(obj.SetNewText(k), runs)
+		case exportedTree:
+			root, err := decodeExportedTree(member)
+			if err != nil {
+				return err
+			}
+			obj.SetNewTree(k, &root)
+		case exportedIntegerCounter, exportedLongCounter:
+			counterType, value, err := decodeExportedCounter(member)
+			if err != nil {
+				return err
+			}
+			obj.SetNewCounter(k, counterType, value)
+		default:
+			value, err := decodeExportedPrimitive(member)
+			if err != nil {
+				return err
+			}
+			obj.SetDynamicValue(k, value)
+		}
+	}
+
+	return nil
+}
+
+// importElements adds the given exported elements to the given array.
+func importElements(arr *json.Array, elements []rawExportedElement) error {
+	for _, elem := range elements {
+		switch elem.Type {
+		case exportedObject:
+			var children map[string]rawExportedElement
+			if err := decodeExportedValue(elem, &children); err != nil {
+				return err
+			}
+			if err := importMembers(arr.AddNewObject(), children); err != nil {
+				return err
+			}
+		case exportedArray:
+			var children []rawExportedElement
+			if err := decodeExportedValue(elem, &children); err != nil {
+				return err
+			}
+			if err := importElements(arr.AddNewArray(), children); err != nil {
+				return err
+			}
+		case exportedText:
+			var runs []exportedTextRun
+			if err := decodeExportedValue(elem, &runs); err != nil {
+				return err
+			}
+			importTextRuns(arr.AddNewText(), runs)
+		case exportedTree:
+			root, err := decodeExportedTree(elem)
+			if err != nil {
+				return err
+			}
+			arr.AddNewTree(&root)
+		case exportedIntegerCounter, exportedLongCounter:
+			counterType, value, err := decodeExportedCounter(elem)
+			if err != nil {
+				return err
+			}
+			arr.AddNewCounter(counterType, value)
+		default:
+			value, err := decodeExportedPrimitive(elem)
+			if err != nil {
+				return err
+			}
+
+			switch value := value.(type) {
+			case nil:
+				arr.AddNull()
+			case bool:
+				arr.AddBool(value)
+			case int:
+				arr.AddInteger(value)
+			case int64:
+				arr.AddLong(value)
+			case float64:
+				arr.AddDouble(value)
+			case string:
+				arr.AddString(value)
+			case []byte:
+				arr.AddBytes(value)
+			case gotime.Time:
+				arr.AddDate(value)
+			}
+		}
+	}
+
+	return nil
+}
+
+// importTextRuns appends the given runs to the given text.
+func importTextRuns(text *json.Text, runs []exportedTextRun) {
+	for _, run := range runs {
+		if run.Attributes != nil {
+			text.Edit(text.Len(), text.Len(), run.Value, run.Attributes)
+		} else {
+			text.Edit(text.Len(), text.Len(), run.Value)
+		}
+	}
+}
+
+// decodeExportedValue decodes the value of the given exported element into v.
+func decodeExportedValue(elem rawExportedElement, v any) error {
+	if err := gojson.Unmarshal(elem.Value, v); err != nil {
+		return fmt.Errorf("decode %s: %s: %w", elem.Type, err.Error(), ErrInvalidExportedJSON)
+	}
+
+	return nil
+}
+
+// decodeExportedTree decodes the root node of the given exported tree.
+func decodeExportedTree(elem rawExportedElement) (json.TreeNode, error) {
+	var root exportedTreeNode
+	if err := decodeExportedValue(elem, &root); err != nil {
+		return json.TreeNode{}, err
+	}
+
+	return importTreeNode(root)
+}
+
+// decodeExportedCounter decodes the type and the value of the given exported
+// counter.
+func decodeExportedCounter(elem rawExportedElement) (crdt.CounterType, any, error) {
+	if elem.Type == exportedIntegerCounter {
+		var value int32
+		if err := decodeExportedValue(elem, &value); err != nil {
+			return 0, nil, err
+		}
+		return crdt.IntegerCnt, value, nil
+	}
+
+	var value int64
+	if err := decodeExportedValue(elem, &value); err != nil {
+		return 0, nil, err
+	}
+	return crdt.LongCnt, value, nil
+}
+
+// decodeExportedPrimitive decodes the value of the given exported primitive.
+func decodeExportedPrimitive(elem rawExportedElement) (any, error) {
+	switch elem.Type {
+	case exportedNull:
+		return nil, nil
+	case exportedBoolean:
+		var value bool
+		err := decodeExportedValue(elem, &value)
+		return value, err
+	case exportedInteger:
+		var value int32
+		err := decodeExportedValue(elem, &value)
+		return int(value), err
+	case exportedLong:
+		var value int64
+		err := decodeExportedValue(elem, &value)
+		return value, err
+	case exportedDouble:
+		var value float64
+		err := decodeExportedValue(elem, &value)
+		return value, err
+	case exportedString:
+		var value string
+		err := decodeExportedValue(elem, &value)
+		return value, err
+	case exportedBytes:
+		var value []byte
+		err := decodeExportedValue(elem, &value)
+		return value, err
+	case exportedDate:
+		var value gotime.Time
+		err := decodeExportedValue(elem, &value)
+		return value, err
+	}
+
+	return nil, fmt.Errorf("unknown type %q: %w", elem.Type, ErrInvalidExportedJSON)
+}
@@ -151,6 +151,7 @@ func TestTextGC(t *testing.T) {
 	const (
 		NoOp opCode = iota
 		Style
+		RemoveStyle
 		DeleteNode
 		GC
 	)
@@ -185,6 +186,17 @@ func TestTextGC(t *testing.T) {
 				{operation{DeleteNode, "", ""}, 1, `[]`},
 			},
 		},
+		{
+			desc: "style-remove style test",
+			steps: []step{
+				{operation{Style, "b", "t"}, 0, `[{"attrs":{"b":"t"},"val":"AB"}]`},
+				{operation{RemoveStyle, "b", ""}, 1, `[{"val":"AB"}]`},
+				{operation{GC, "", ""}, 0, `[{"val":"AB"}]`},
+				{operation{RemoveStyle, "b", ""}, 1, `[{"val":"AB"}]`},
+				{operation{RemoveStyle, "b", ""}, 1, `[{"val":"AB"}]`},
+				{operation{Style, "b", "t"}, 0, `[{"attrs":{"b":"t"},"val":"AB"}]`},
+			},
+		},
 	}
 
 	for i, tc := range tests {
@@ -203,6 +215,8 @@ func TestTextGC(t *testing.T) {
 				assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
 					if s.op.code == Style {
 						root.GetText("t").Style(0, 2, map[string]string{s.op.key: s.op.val})
+					} else if s.op.code == RemoveStyle {
+						root.GetText("t").RemoveStyle(0, 2, []string{s.op.key})
 					} else if s.op.code == DeleteNode {
 						root.GetText("t").Edit(0, 2, "")
 					} else if s.op.code == GC {
@@ -0,0 +1,251 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"errors"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// MaxUndoRedoStackDepth is the maximum depth of undo/redo stack.
+const MaxUndoRedoStackDepth = 50
+
+var (
+	// ErrNothingToUndo is returned when there is no change to undo.
+	ErrNothingToUndo = errors.New("nothing to undo")
+
+	// ErrNothingToRedo is returned when there is no change to redo.
+	ErrNothingToRedo = errors.New("nothing to redo")
+)
+
+// History manages the undo/redo stacks of the local changes of a document.
+// Each entry of the stacks is a list of reverse operations of a change, and
+// executing an entry issues a new local change.
+type History struct {
+	doc       *Document
+	undoStack [][]historyOperation
+	redoStack [][]historyOperation
+}
+
+// newHistory creates a new instance of History.
+func newHistory(doc *Document) *History {
+	return &History{doc: doc}
+}
+
+// CanUndo returns whether there is a change to undo or not.
+func (h *History) CanUndo() bool {
+	return len(h.undoStack) > 0
+}
+
+// CanRedo returns whether there is a change to redo or not.
+func (h *History) CanRedo() bool {
+	return len(h.redoStack) > 0
+}
+
+// Undo reverts the last local change of the document.
+func (h *History) Undo() error {
+	h.doc.lock()
+	defer h.doc.unlock()
+
+	if !h.CanUndo() {
+		return ErrNothingToUndo
+	}
+
+	// NOTE: The entry is popped only after it is executed successfully, so
+	// that it can be retried when the execution fails.
+	reverse, err := h.doc.executeHistory(h.undoStack[len(h.undoStack)-1])
+	if err != nil {
+		return err
+	}
+
+	h.undoStack = h.undoStack[:len(h.undoStack)-1]
+	h.redoStack = pushEntry(h.redoStack, reverse)
+	return nil
+}
+
+// Redo reapplies the last change reverted by Undo.
+func (h *History) Redo() error {
+	h.doc.lock()
+	defer h.doc.unlock()
+
+	if !h.CanRedo() {
+		return ErrNothingToRedo
+	}
+
+	reverse, err := h.doc.executeHistory(h.redoStack[len(h.redoStack)-1])
+	if err != nil {
+		return err
+	}
+
+	h.redoStack = h.redoStack[:len(h.redoStack)-1]
+	h.undoStack = pushEntry(h.undoStack, reverse)
+	return nil
+}
+
+// Clear clears the undo/redo stacks.
+func (h *History) Clear() {
+	h.undoStack = nil
+	h.redoStack = nil
+}
+
+// pushUndo pushes the reverse operations of a new local change. Because the
+// new change diverges from the changes reverted so far, it clears the redo
+// stack.
+func (h *History) pushUndo(entry []historyOperation) {
+	h.undoStack = pushEntry(h.undoStack, entry)
+	h.redoStack = nil
+}
+
+// remap replaces the creation times of elements referenced by the stacks
+// with the given mapping. It is used when elements are recreated by undo or
+// redo.
+func (h *History) remap(mapping map[string]*time.Ticket) {
+	for _, stack := range [][][]historyOperation{h.undoStack, h.redoStack} {
+		for _, entry := range stack {
+			for _, op := range entry {
+				op.remap(mapping)
+			}
+		}
+	}
+}
+
+// rebase adjusts the positions of the stacks against the remote edit that
+// replaced [from, to) of the given element and then inserted the content of
+// the given length at the given index.
+func (h *History) rebase(parentCreatedAt *time.Ticket, from, to, at, length int) {
+	for _, stack := range [][][]historyOperation{h.undoStack, h.redoStack} {
+		for _, entry := range stack {
+			for _, op := range entry {
+				op.rebase(parentCreatedAt, from, to, at, length)
+			}
+		}
+	}
+}
+
+// interceptRemote returns an interceptor that rebases the stacks while remote
+// operations are executed on the given root.
+func (h *History) interceptRemote(root *crdt.Root) change.Interceptor {
+	return func(op operations.Operation, execute func() error) error {
+		if !h.CanUndo() && !h.CanRedo() {
+			return execute()
+		}
+
+		switch op := op.(type) {
+		case *operations.Edit:
+			text, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Text)
+			if !ok {
+				return execute()
+			}
+			from, to, rangeErr := text.FindIndexesFromRange(op.From(), op.To())
+			if err := execute(); err != nil {
+				return err
+			}
+			if rangeErr != nil {
+				return nil
+			}
+
+			// NOTE: The inserted content may be placed after the concurrent
+			// contents, so we find the index of the inserted node by its ID.
+			at, length := from, utf16Len(op.Content())
+			if length > 0 {
+				pos := crdt.NewRGATreeSplitNodePos(crdt.NewRGATreeSplitNodeID(op.ExecutedAt(), 0), 0)
+				idx, _, err := text.FindIndexesFromRange(pos, pos)
+				if err != nil {
+					return nil
+				}
+				at = idx
+			}
+			h.rebase(op.ParentCreatedAt(), from, to, at, length)
+			return nil
+		case *operations.TreeEdit:
+			tree, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Tree)
+			if !ok {
+				return execute()
+			}
+			from, fromErr := tree.PosToIndex(op.FromPos())
+			to, toErr := tree.PosToIndex(op.ToPos())
+			size := tree.IndexTree.Root().Len()
+			if err := execute(); err != nil {
+				return err
+			}
+			if fromErr != nil || toErr != nil {
+				return nil
+			}
+
+			length := tree.IndexTree.Root().Len() - size + (to - from)
+			h.rebase(op.ParentCreatedAt(), from, to, from, length)
+			return nil
+		case *operations.TreeMove:
+			tree, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Tree)
+			if !ok {
+				return execute()
+			}
+			from, to, rangeErr := tree.NodeRange(op.NodeID())
+			if err := execute(); err != nil {
+				return err
+			}
+
+			// NOTE: Moving is regarded as deleting the node and then
+			// inserting it at the target.
+			if rangeErr == nil {
+				h.rebase(op.ParentCreatedAt(), from, to, from, 0)
+			}
+			at, end, err := tree.NodeRange(op.NodeID())
+			if err != nil {
+				return nil
+			}
+			h.rebase(op.ParentCreatedAt(), at, at, at, end-at)
+			return nil
+		}
+
+		return execute()
+	}
+}
+
+// pushEntry pushes the given entry to the given stack. If the stack exceeds
+// MaxUndoRedoStackDepth, the oldest entry is dropped.
+func pushEntry(stack [][]historyOperation, entry []historyOperation) [][]historyOperation {
+	if len(entry) == 0 {
+		return stack
+	}
+
+	stack = append(stack, entry)
+	if len(stack) > MaxUndoRedoStackDepth {
+		stack = stack[len(stack)-MaxUndoRedoStackDepth:]
+	}
+	return stack
+}
+
+// interceptLocal returns an interceptor that collects the reverse operations
+// of local operations while they are executed on the given root.
+func interceptLocal(root *crdt.Root, reverse *[]historyOperation) change.Interceptor {
+	return func(op operations.Operation, execute func() error) error {
+		ops := reverseOperations(root, op)
+		if err := execute(); err != nil {
+			return err
+		}
+
+		// Reverse operations should be executed in the reverse order of the
+		// original operations.
+		*reverse = append(ops, *reverse...)
+		return nil
+	}
+}
@@ -0,0 +1,1182 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"sort"
+	"unicode/utf16"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/index"
+)
+
+// historyOperation is an operation stored in the undo/redo stacks. Unlike
+// operations.Operation, it does not hold the execution time because it is
+// converted to a new operation of a new change when it is executed.
+type historyOperation interface {
+	// execute executes this operation on the given root with the given
+	// context. The creation times of the elements recreated by this operation
+	// are recorded in the given mapping.
+	execute(ctx *change.Context, root *crdt.Root, mapping map[string]*time.Ticket) error
+
+	// remap replaces the creation times of the referenced elements with the
+	// given mapping.
+	remap(mapping map[string]*time.Ticket)
+
+	// clone returns a copy of this operation that can be remapped without
+	// affecting this operation.
+	clone() historyOperation
+
+	// rebase adjusts the positions of this operation against the remote edit
+	// that replaced [from, to) of the given element and then inserted the
+	// content of the given length at the given index.
+	rebase(parentCreatedAt *time.Ticket, from, to, at, length int)
+}
+
+// objectSetOperation sets the given value to the given key of the object.
+type objectSetOperation struct {
+	parentCreatedAt *time.Ticket
+	key             string
+	value           crdt.Element
+}
+
+func (o *objectSetOperation) execute(
+	ctx *change.Context,
+	root *crdt.Root,
+	mapping map[string]*time.Ticket,
+) error {
+	if !isAlive(root, o.parentCreatedAt) {
+		return nil
+	}
+	if _, ok := root.FindByCreatedAt(o.parentCreatedAt).(*crdt.Object); !ok {
+		return nil
+	}
+
+	ticket := ctx.IssueTimeTicket()
+	value, err := rebuildElement(ctx, o.value, ticket, mapping)
+	if err != nil {
+		return err
+	}
+
+	op := operations.NewSet(o.parentCreatedAt, o.key, value, ticket)
+	if err := op.Execute(root, nil); err != nil {
+		return err
+	}
+	ctx.Push(op)
+	return nil
+}
+
+func (o *objectSetOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+}
+
+func (o *objectSetOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *objectSetOperation) rebase(_ *time.Ticket, _, _, _, _ int) {}
+
+// removeOperation removes the given element from the container.
+type removeOperation struct {
+	parentCreatedAt *time.Ticket
+	createdAt       *time.Ticket
+}
+
+func (o *removeOperation) execute(ctx *change.Context, root *crdt.Root, _ map[string]*time.Ticket) error {
+	if !isAlive(root, o.parentCreatedAt) || !isAlive(root, o.createdAt) {
+		return nil
+	}
+
+	op := operations.NewRemove(o.parentCreatedAt, o.createdAt, ctx.IssueTimeTicket())
+	if err := op.Execute(root, nil); err != nil {
+		return err
+	}
+	ctx.Push(op)
+	return nil
+}
+
+func (o *removeOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+	o.createdAt = remapTicket(mapping, o.createdAt)
+}
+
+func (o *removeOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *removeOperation) rebase(_ *time.Ticket, _, _, _, _ int) {}
+
+// arrayAddOperation adds the given value after the given previous element.
+type arrayAddOperation struct {
+	parentCreatedAt *time.Ticket
+	prevCreatedAt   *time.Ticket
+	value           crdt.Element
+}
+
+func (o *arrayAddOperation) execute(
+	ctx *change.Context,
+	root *crdt.Root,
+	mapping map[string]*time.Ticket,
+) error {
+	if !isAlive(root, o.parentCreatedAt) {
+		return nil
+	}
+	if _, ok := root.FindByCreatedAt(o.parentCreatedAt).(*crdt.Array); !ok {
+		return nil
+	}
+
+	// NOTE: If the previous element has been purged, the value is added to
+	// the front of the array.
+	prevCreatedAt := o.prevCreatedAt
+	if prevCreatedAt.Compare(time.InitialTicket) != 0 && root.FindByCreatedAt(prevCreatedAt) == nil {
+		prevCreatedAt = time.InitialTicket
+	}
+
+	ticket := ctx.IssueTimeTicket()
+	value, err := rebuildElement(ctx, o.value, ticket, mapping)
+	if err != nil {
+		return err
+	}
+
+	op := operations.NewAdd(o.parentCreatedAt, prevCreatedAt, value, ticket)
+	if err := op.Execute(root, nil); err != nil {
+		return err
+	}
+	ctx.Push(op)
+	return nil
+}
+
+func (o *arrayAddOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+	o.prevCreatedAt = remapTicket(mapping, o.prevCreatedAt)
+}
+
+func (o *arrayAddOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *arrayAddOperation) rebase(_ *time.Ticket, _, _, _, _ int) {}
+
+// arrayMoveOperation moves the given element after the given previous element.
+type arrayMoveOperation struct {
+	parentCreatedAt *time.Ticket
+	prevCreatedAt   *time.Ticket
+	createdAt       *time.Ticket
+}
+
+func (o *arrayMoveOperation) execute(ctx *change.Context, root *crdt.Root, _ map[string]*time.Ticket) error {
+	if !isAlive(root, o.parentCreatedAt) || !isAlive(root, o.createdAt) {
+		return nil
+	}
+
+	prevCreatedAt := o.prevCreatedAt
+	if prevCreatedAt.Compare(time.InitialTicket) != 0 && root.FindByCreatedAt(prevCreatedAt) == nil {
+		prevCreatedAt = time.InitialTicket
+	}
+
+	op := operations.NewMove(o.parentCreatedAt, prevCreatedAt, o.createdAt, ctx.IssueTimeTicket())
+	if err := op.Execute(root, nil); err != nil {
+		return err
+	}
+	ctx.Push(op)
+	return nil
+}
+
+func (o *arrayMoveOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+	o.prevCreatedAt = remapTicket(mapping, o.prevCreatedAt)
+	o.createdAt = remapTicket(mapping, o.createdAt)
+}
+
+func (o *arrayMoveOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *arrayMoveOperation) rebase(_ *time.Ticket, _, _, _, _ int) {}
+
+// arraySetOperation replaces the given element of the array with the value.
+type arraySetOperation struct {
+	parentCreatedAt *time.Ticket
+	createdAt       *time.Ticket
+	value           crdt.Element
+}
+
+func (o *arraySetOperation) execute(
+	ctx *change.Context,
+	root *crdt.Root,
+	mapping map[string]*time.Ticket,
+) error {
+	if !isAlive(root, o.parentCreatedAt) || !isAlive(root, o.createdAt) {
+		return nil
+	}
+
+	// NOTE: The new element must have the same `createdAt` as the old element.
+	ticket := ctx.IssueTimeTicket()
+	value, err := rebuildElement(ctx, o.value, o.createdAt, mapping)
+	if err != nil {
+		return err
+	}
+
+	op := operations.NewArraySet(o.parentCreatedAt, o.createdAt, value, ticket)
+	if err := op.Execute(root, nil); err != nil {
+		return err
+	}
+	ctx.Push(op)
+	return nil
+}
+
+func (o *arraySetOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+	o.createdAt = remapTicket(mapping, o.createdAt)
+}
+
+func (o *arraySetOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *arraySetOperation) rebase(_ *time.Ticket, _, _, _, _ int) {}
+
+// increaseOperation increases the counter by the given value.
+type increaseOperation struct {
+	parentCreatedAt *time.Ticket
+	value           interface{}
+}
+
+func (o *increaseOperation) execute(ctx *change.Context, root *crdt.Root, _ map[string]*time.Ticket) error {
+	if !isAlive(root, o.parentCreatedAt) {
+		return nil
+	}
+
+	ticket := ctx.IssueTimeTicket()
+	value, err := crdt.NewPrimitive(o.value, ticket)
+	if err != nil {
+		return err
+	}
+
+	op := operations.NewIncrease(o.parentCreatedAt, value, ticket)
+	if err := op.Execute(root, nil); err != nil {
+		return err
+	}
+	ctx.Push(op)
+	return nil
+}
+
+func (o *increaseOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+}
+
+func (o *increaseOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *increaseOperation) rebase(_ *time.Ticket, _, _, _, _ int) {}
+
+// textEditOperation replaces [from, to) of the text with the given content.
+type textEditOperation struct {
+	parentCreatedAt *time.Ticket
+	from            int
+	to              int
+	content         string
+	attributes      map[string]string
+}
+
+func (o *textEditOperation) execute(ctx *change.Context, root *crdt.Root, _ map[string]*time.Ticket) error {
+	if !isAlive(root, o.parentCreatedAt) {
+		return nil
+	}
+	text, ok := root.FindByCreatedAt(o.parentCreatedAt).(*crdt.Text)
+	if !ok {
+		return nil
+	}
+
+	from, to := clampRange(o.from, o.to, text.Len())
+	if from == to && o.content == "" {
+		return nil
+	}
+
+	json.NewText().Initialize(ctx, text).Edit(from, to, o.content, o.attributes)
+	return nil
+}
+
+func (o *textEditOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+}
+
+func (o *textEditOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *textEditOperation) rebase(parentCreatedAt *time.Ticket, from, to, at, length int) {
+	if o.parentCreatedAt.Compare(parentCreatedAt) != 0 {
+		return
+	}
+	o.from, o.to = rebaseRange(o.from, o.to, from, to, at, length)
+}
+
+// textStyleOperation sets and removes the given attributes of [from, to) of
+// the text.
+type textStyleOperation struct {
+	parentCreatedAt    *time.Ticket
+	from               int
+	to                 int
+	attributes         map[string]string
+	attributesToRemove []string
+}
+
+func (o *textStyleOperation) execute(ctx *change.Context, root *crdt.Root, _ map[string]*time.Ticket) error {
+	if !isAlive(root, o.parentCreatedAt) {
+		return nil
+	}
+	text, ok := root.FindByCreatedAt(o.parentCreatedAt).(*crdt.Text)
+	if !ok {
+		return nil
+	}
+
+	from, to := clampRange(o.from, o.to, text.Len())
+	if from == to {
+		return nil
+	}
+
+	proxy := json.NewText().Initialize(ctx, text)
+	if len(o.attributes) > 0 {
+		proxy.Style(from, to, o.attributes)
+	}
+	proxy.RemoveStyle(from, to, o.attributesToRemove)
+	return nil
+}
+
+func (o *textStyleOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+}
+
+func (o *textStyleOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *textStyleOperation) rebase(parentCreatedAt *time.Ticket, from, to, at, length int) {
+	if o.parentCreatedAt.Compare(parentCreatedAt) != 0 {
+		return
+	}
+	o.from, o.to = rebaseRange(o.from, o.to, from, to, at, length)
+}
+
+// treeEditOperation removes [from, to) of the tree and then inserts the
+// given groups of nodes at `from`. Each group consists of nodes of the same
+// kind, text or element.
+type treeEditOperation struct {
+	parentCreatedAt *time.Ticket
+	from            int
+	to              int
+	groups          [][]*json.TreeNode
+}
+
+func (o *treeEditOperation) execute(ctx *change.Context, root *crdt.Root, _ map[string]*time.Ticket) error {
+	if !isAlive(root, o.parentCreatedAt) {
+		return nil
+	}
+	crdtTree, ok := root.FindByCreatedAt(o.parentCreatedAt).(*crdt.Tree)
+	if !ok {
+		return nil
+	}
+
+	tree := json.NewTree().Initialize(ctx, crdtTree)
+	from, to := clampRange(o.from, o.to, tree.Len())
+	if len(o.groups) == 0 {
+		if from < to {
+			tree.Edit(from, to, nil, 0)
+		}
+		return nil
+	}
+
+	for i, group := range o.groups {
+		if i == 0 {
+			tree.EditBulk(from, to, group, 0)
+		} else {
+			tree.EditBulk(from, from, group, 0)
+		}
+
+		for _, node := range group {
+			from += treeNodeSize(node)
+		}
+	}
+
+	return nil
+}
+
+func (o *treeEditOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+}
+
+func (o *treeEditOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *treeEditOperation) rebase(parentCreatedAt *time.Ticket, from, to, at, length int) {
+	if o.parentCreatedAt.Compare(parentCreatedAt) != 0 {
+		return
+	}
+	o.from, o.to = rebaseRange(o.from, o.to, from, to, at, length)
+}
+
+// treeStyleOperation sets and removes the given attributes of the nodes in
+// [from, to) of the tree.
+type treeStyleOperation struct {
+	parentCreatedAt    *time.Ticket
+	from               int
+	to                 int
+	attributes         map[string]string
+	attributesToRemove []string
+}
+
+func (o *treeStyleOperation) execute(ctx *change.Context, root *crdt.Root, _ map[string]*time.Ticket) error {
+	if !isAlive(root, o.parentCreatedAt) {
+		return nil
+	}
+	crdtTree, ok := root.FindByCreatedAt(o.parentCreatedAt).(*crdt.Tree)
+	if !ok {
+		return nil
+	}
+
+	tree := json.NewTree().Initialize(ctx, crdtTree)
+	from, to := clampRange(o.from, o.to, tree.Len())
+	if from == to {
+		return nil
+	}
+
+	tree.Style(from, to, o.attributes)
+	tree.RemoveStyle(from, to, o.attributesToRemove)
+	return nil
+}
+
+func (o *treeStyleOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+}
+
+func (o *treeStyleOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *treeStyleOperation) rebase(parentCreatedAt *time.Ticket, from, to, at, length int) {
+	if o.parentCreatedAt.Compare(parentCreatedAt) != 0 {
+		return
+	}
+	o.from, o.to = rebaseRange(o.from, o.to, from, to, at, length)
+}
+
+// treeMoveOperation moves the given node of the tree to `at`.
+type treeMoveOperation struct {
+	parentCreatedAt *time.Ticket
+	nodeID          *crdt.TreeNodeID
+	at              int
+}
+
+func (o *treeMoveOperation) execute(ctx *change.Context, root *crdt.Root, _ map[string]*time.Ticket) error {
+	if !isAlive(root, o.parentCreatedAt) {
+		return nil
+	}
+	tree, ok := root.FindByCreatedAt(o.parentCreatedAt).(*crdt.Tree)
+	if !ok {
+		return nil
+	}
+
+	from, to, err := tree.NodeRange(o.nodeID)
+	if err != nil {
+		return nil
+	}
+	at, _ := clampRange(o.at, o.at, tree.IndexTree.Root().Len())
+	if from <= at && at <= to {
+		return nil
+	}
+
+	target, err := tree.FindPos(at)
+	if err != nil {
+		return err
+	}
+	ticket := ctx.IssueTimeTicket()
+	pairs, err := tree.Move(o.nodeID, target, ticket, nil)
+	if err != nil {
+		return err
+	}
+	for _, pair := range pairs {
+		ctx.RegisterGCPair(pair)
+	}
+	ctx.Push(operations.NewTreeMove(o.parentCreatedAt, o.nodeID, target, ticket))
+	return nil
+}
+
+func (o *treeMoveOperation) remap(mapping map[string]*time.Ticket) {
+	o.parentCreatedAt = remapTicket(mapping, o.parentCreatedAt)
+}
+
+func (o *treeMoveOperation) clone() historyOperation {
+	c := *o
+	return &c
+}
+
+func (o *treeMoveOperation) rebase(parentCreatedAt *time.Ticket, from, to, at, length int) {
+	if o.parentCreatedAt.Compare(parentCreatedAt) != 0 {
+		return
+	}
+	o.at, _ = rebaseRange(o.at, o.at, from, to, at, length)
+}
+
+// reverseOperations returns the operations that revert the given operation.
+// It should be called before the operation is executed on the given root.
+// If the operation cannot be reverted, it returns nil.
+func reverseOperations(root *crdt.Root, op operations.Operation) []historyOperation {
+	switch op := op.(type) {
+	case *operations.Set:
+		obj, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Object)
+		if !ok {
+			return nil
+		}
+
+		if prev := obj.Get(op.Key()); prev != nil {
+			value, err := prev.DeepCopy()
+			if err != nil {
+				return nil
+			}
+			return []historyOperation{&objectSetOperation{
+				parentCreatedAt: op.ParentCreatedAt(),
+				key:             op.Key(),
+				value:           value,
+			}}
+		}
+
+		return []historyOperation{&removeOperation{
+			parentCreatedAt: op.ParentCreatedAt(),
+			createdAt:       op.Value().CreatedAt(),
+		}}
+	case *operations.Add:
+		return []historyOperation{&removeOperation{
+			parentCreatedAt: op.ParentCreatedAt(),
+			createdAt:       op.Value().CreatedAt(),
+		}}
+	case *operations.Remove:
+		elem := root.FindByCreatedAt(op.CreatedAt())
+		if elem == nil || elem.RemovedAt() != nil {
+			return nil
+		}
+		value, err := elem.DeepCopy()
+		if err != nil {
+			return nil
+		}
+
+		switch parent := root.FindByCreatedAt(op.ParentCreatedAt()).(type) {
+		case *crdt.Object:
+			for _, node := range parent.RHTNodes() {
+				if node.Element() == elem {
+					return []historyOperation{&objectSetOperation{
+						parentCreatedAt: op.ParentCreatedAt(),
+						key:             node.Key(),
+						value:           value,
+					}}
+				}
+			}
+		case *crdt.Array:
+			prevCreatedAt, err := parent.FindPrevCreatedAt(op.CreatedAt())
+			if err != nil {
+				return nil
+			}
+			return []historyOperation{&arrayAddOperation{
+				parentCreatedAt: op.ParentCreatedAt(),
+				prevCreatedAt:   prevCreatedAt,
+				value:           value,
+			}}
+		}
+	case *operations.Move:
+		array, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Array)
+		if !ok {
+			return nil
+		}
+		prevCreatedAt, err := array.FindPrevCreatedAt(op.CreatedAt())
+		if err != nil {
+			return nil
+		}
+		return []historyOperation{&arrayMoveOperation{
+			parentCreatedAt: op.ParentCreatedAt(),
+			prevCreatedAt:   prevCreatedAt,
+			createdAt:       op.CreatedAt(),
+		}}
+	case *operations.ArraySet:
+		elem := root.FindByCreatedAt(op.CreatedAt())
+		if elem == nil || elem.RemovedAt() != nil {
+			return nil
+		}
+		value, err := elem.DeepCopy()
+		if err != nil {
+			return nil
+		}
+		return []historyOperation{&arraySetOperation{
+			parentCreatedAt: op.ParentCreatedAt(),
+			createdAt:       op.CreatedAt(),
+			value:           value,
+		}}
+	case *operations.Increase:
+		value, ok := negate(op.Value().(*crdt.Primitive).Value())
+		if !ok {
+			return nil
+		}
+		return []historyOperation{&increaseOperation{
+			parentCreatedAt: op.ParentCreatedAt(),
+			value:           value,
+		}}
+	case *operations.Edit:
+		return reverseEdit(root, op)
+	case *operations.Style:
+		return reverseStyle(root, op)
+	case *operations.TreeEdit:
+		return reverseTreeEdit(root, op)
+	case *operations.TreeStyle:
+		return reverseTreeStyle(root, op)
+	case *operations.TreeMove:
+		return reverseTreeMove(root, op)
+	}
+
+	return nil
+}
+
+// reverseEdit returns the operations that revert the given text edit.
+func reverseEdit(root *crdt.Root, op *operations.Edit) []historyOperation {
+	text, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Text)
+	if !ok {
+		return nil
+	}
+	from, to, err := text.FindIndexesFromRange(op.From(), op.To())
+	if err != nil {
+		return nil
+	}
+
+	contentLen := utf16Len(op.Content())
+	var ops []historyOperation
+	at := from
+	for _, node := range textNodesBetween(text, from, to) {
+		value := node.Value().Value()
+		if len(ops) == 0 {
+			ops = append(ops, &textEditOperation{
+				parentCreatedAt: op.ParentCreatedAt(),
+				from:            from,
+				to:              from + contentLen,
+				content:         value,
+				attributes:      node.Value().Attrs().Elements(),
+			})
+		} else {
+			ops = append(ops, &textEditOperation{
+				parentCreatedAt: op.ParentCreatedAt(),
+				from:            at,
+				to:              at,
+				content:         value,
+				attributes:      node.Value().Attrs().Elements(),
+			})
+		}
+		at += utf16Len(value)
+	}
+
+	if len(ops) == 0 && contentLen > 0 {
+		ops = append(ops, &textEditOperation{
+			parentCreatedAt: op.ParentCreatedAt(),
+			from:            from,
+			to:              from + contentLen,
+		})
+	}
+
+	return ops
+}
+
+// reverseStyle returns the operations that revert the given text style.
+func reverseStyle(root *crdt.Root, op *operations.Style) []historyOperation {
+	text, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Text)
+	if !ok {
+		return nil
+	}
+	from, to, err := text.FindIndexesFromRange(op.From(), op.To())
+	if err != nil {
+		return nil
+	}
+
+	keys := append([]string{}, op.AttributesToRemove()...)
+	for key := range op.Attributes() {
+		keys = append(keys, key)
+	}
+	if len(keys) == 0 {
+		return nil
+	}
+
+	var ops []historyOperation
+	at := from
+	for _, node := range textNodesBetween(text, from, to) {
+		length := utf16Len(node.Value().Value())
+		reverse := &textStyleOperation{
+			parentCreatedAt: op.ParentCreatedAt(),
+			from:            at,
+			to:              at + length,
+			attributes:      make(map[string]string),
+		}
+		for _, key := range keys {
+			if node.Value().Attrs().Has(key) {
+				reverse.attributes[key] = node.Value().Attrs().Get(key)
+			} else {
+				reverse.attributesToRemove = append(reverse.attributesToRemove, key)
+			}
+		}
+
+		ops = append(ops, reverse)
+		at += length
+	}
+
+	return ops
+}
+
+// reverseTreeEdit returns the operations that revert the given tree edit. It
+// can revert the edit only if the removed range consists of whole nodes or
+// parts of text nodes.
+func reverseTreeEdit(root *crdt.Root, op *operations.TreeEdit) []historyOperation {
+	tree, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Tree)
+	if !ok || op.SplitLevel() > 0 {
+		return nil
+	}
+	from, err := tree.PosToIndex(op.FromPos())
+	if err != nil {
+		return nil
+	}
+	to, err := tree.PosToIndex(op.ToPos())
+	if err != nil {
+		return nil
+	}
+
+	removed, ok := treeNodesBetween(tree.Root().Index, from, to)
+	if !ok {
+		return nil
+	}
+
+	inserted := 0
+	for _, content := range op.Contents() {
+		inserted += content.Index.PaddedLength()
+	}
+	if inserted == 0 && len(removed) == 0 {
+		return nil
+	}
+
+	var groups [][]*json.TreeNode
+	for i, node := range removed {
+		if i == 0 || removed[i-1].Type == index.DefaultTextType != (node.Type == index.DefaultTextType) {
+			groups = append(groups, nil)
+		}
+		groups[len(groups)-1] = append(groups[len(groups)-1], node)
+	}
+
+	return []historyOperation{&treeEditOperation{
+		parentCreatedAt: op.ParentCreatedAt(),
+		from:            from,
+		to:              from + inserted,
+		groups:          groups,
+	}}
+}
+
+// reverseTreeStyle returns the operations that revert the given tree style.
+func reverseTreeStyle(root *crdt.Root, op *operations.TreeStyle) []historyOperation {
+	tree, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Tree)
+	if !ok {
+		return nil
+	}
+	from, err := tree.PosToIndex(op.FromPos())
+	if err != nil {
+		return nil
+	}
+	to, err := tree.PosToIndex(op.ToPos())
+	if err != nil {
+		return nil
+	}
+
+	keys := op.AttributesToRemove()
+	for key := range op.Attributes() {
+		keys = append(keys, key)
+	}
+
+	var ops []historyOperation
+	traverseStyledNodes(tree.Root().Index, 0, from, to, func(node *crdt.TreeNode, from, to int) {
+		reverse := &treeStyleOperation{
+			parentCreatedAt: op.ParentCreatedAt(),
+			from:            from,
+			to:              to,
+			attributes:      make(map[string]string),
+		}
+		for _, key := range keys {
+			if node.Attrs != nil && node.Attrs.Has(key) {
+				reverse.attributes[key] = node.Attrs.Get(key)
+			} else {
+				reverse.attributesToRemove = append(reverse.attributesToRemove, key)
+			}
+		}
+		ops = append(ops, reverse)
+	})
+
+	return ops
+}
+
+// reverseTreeMove returns the operations that move the node of the given
+// tree move back to its original position.
+func reverseTreeMove(root *crdt.Root, op *operations.TreeMove) []historyOperation {
+	tree, ok := root.FindByCreatedAt(op.ParentCreatedAt()).(*crdt.Tree)
+	if !ok {
+		return nil
+	}
+	from, to, err := tree.NodeRange(op.NodeID())
+	if err != nil {
+		return nil
+	}
+	target, err := tree.PosToIndex(op.TargetPos())
+	if err != nil {
+		return nil
+	}
+
+	// NOTE: If the node is moved backward, the original position is pushed
+	// back by the length of the node.
+	at := from
+	if target <= from {
+		at += to - from
+	}
+
+	return []historyOperation{&treeMoveOperation{
+		parentCreatedAt: op.ParentCreatedAt(),
+		nodeID:          op.NodeID(),
+		at:              at,
+	}}
+}
+
+// rebuildElement rebuilds the given element with the given ticket. The
+// descendants of the element are rebuilt with new tickets issued by the given
+// context, and the mapping from the old creation times to the new ones is
+// recorded in the given mapping. Removed descendants are not rebuilt.
+func rebuildElement(
+	ctx *change.Context,
+	elem crdt.Element,
+	ticket *time.Ticket,
+	mapping map[string]*time.Ticket,
+) (crdt.Element, error) {
+	if elem.CreatedAt().Compare(ticket) != 0 {
+		mapping[elem.CreatedAt().Key()] = ticket
+	}
+
+	switch elem := elem.(type) {
+	case *crdt.Primitive:
+		return crdt.NewPrimitive(elem.Value(), ticket)
+	case *crdt.Counter:
+		return crdt.NewCounter(elem.ValueType(), elem.Value(), ticket)
+	case *crdt.Object:
+		obj := crdt.NewObject(crdt.NewElementRHT(), ticket)
+		members := elem.Members()
+		keys := make([]string, 0, len(members))
+		for key := range members {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			child, err := rebuildElement(ctx, members[key], ctx.IssueTimeTicket(), mapping)
+			if err != nil {
+				return nil, err
+			}
+			obj.Set(key, child)
+		}
+		return obj, nil
+	case *crdt.Array:
+		array := crdt.NewArray(crdt.NewRGATreeList(), ticket)
+		for _, member := range elem.Elements() {
+			child, err := rebuildElement(ctx, member, ctx.IssueTimeTicket(), mapping)
+			if err != nil {
+				return nil, err
+			}
+			if err := array.Add(child); err != nil {
+				return nil, err
+			}
+		}
+		return array, nil
+	case *crdt.Text:
+		text := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ticket)
+		for _, node := range elem.Nodes() {
+			if node.RemovedAt() != nil {
+				continue
+			}
+
+			from, to, err := text.CreateRange(text.Len(), text.Len())
+			if err != nil {
+				return nil, err
+			}
+			if _, _, _, err := text.Edit(
+				from,
+				to,
+				nil,
+				node.Value().Value(),
+				node.Value().Attrs().Elements(),
+				ctx.IssueTimeTicket(),
+				nil,
+			); err != nil {
+				return nil, err
+			}
+		}
+		return text, nil
+	case *crdt.Tree:
+		root, err := rebuildTreeNode(ctx, elem.Root(), ticket)
+		if err != nil {
+			return nil, err
+		}
+		return crdt.NewTree(root, ticket), nil
+	}
+
+	return nil, operations.ErrNotApplicableDataType
+}
+
+// rebuildTreeNode rebuilds the given tree node and its live descendants with
+// new tickets.
+func rebuildTreeNode(ctx *change.Context, node *crdt.TreeNode, ticket *time.Ticket) (*crdt.TreeNode, error) {
+	if node.IsText() {
+		return crdt.NewTreeNode(crdt.NewTreeNodeID(ticket, 0), node.Type(), nil, node.Value), nil
+	}
+
+	var attrs *crdt.RHT
+	if node.Attrs != nil && node.Attrs.Len() > 0 {
+		attrs = crdt.NewRHT()
+		for key, value := range node.Attrs.Elements() {
+			attrs.Set(key, value, ticket)
+		}
+	}
+
+	rebuilt := crdt.NewTreeNode(crdt.NewTreeNodeID(ticket, 0), node.Type(), attrs)
+	for _, child := range node.Index.Children() {
+		rebuiltChild, err := rebuildTreeNode(ctx, child.Value, ctx.IssueTimeTicket())
+		if err != nil {
+			return nil, err
+		}
+		if err := rebuilt.Append(rebuiltChild); err != nil {
+			return nil, err
+		}
+	}
+
+	return rebuilt, nil
+}
+
+// textNodesBetween returns the live text nodes in [from, to) of the given
+// text. The nodes at the boundaries are sliced to fit the range.
+func textNodesBetween(text *crdt.Text, from, to int) []*crdt.RGATreeSplitNode[*crdt.TextValue] {
+	var nodes []*crdt.RGATreeSplitNode[*crdt.TextValue]
+
+	pos := 0
+	for _, node := range text.Nodes() {
+		if node.RemovedAt() != nil {
+			continue
+		}
+
+		start, end := pos, pos+node.Len()
+		pos = end
+		if end <= from || to <= start {
+			continue
+		}
+
+		value := sliceUTF16(node.Value().Value(), max(from, start)-start, min(to, end)-start)
+		nodes = append(nodes, crdt.NewRGATreeSplitNode(
+			node.ID(),
+			crdt.NewTextValue(value, node.Value().Attrs()),
+		))
+	}
+
+	return nodes
+}
+
+// treeNodesBetween returns the nodes in [from, to) of the given node. It
+// returns false if the range includes only one side of an element node.
+func treeNodesBetween(node *index.Node[*crdt.TreeNode], from, to int) ([]*json.TreeNode, bool) {
+	var nodes []*json.TreeNode
+
+	pos := 0
+	for _, child := range node.Children() {
+		start, end := pos, pos+child.PaddedLength()
+		pos = end
+		if end <= from || to <= start {
+			continue
+		}
+
+		if child.IsText() {
+			nodes = append(nodes, &json.TreeNode{
+				Type:  index.DefaultTextType,
+				Value: sliceUTF16(child.Value.Value, max(from, start)-start, min(to, end)-start),
+			})
+			continue
+		}
+
+		if from <= start && end <= to {
+			converted := toJSONTreeNode(child.Value)
+			nodes = append(nodes, &converted)
+			continue
+		}
+
+		if start < from && to < end {
+			return treeNodesBetween(child, from-start-1, to-start-1)
+		}
+
+		return nil, false
+	}
+
+	return nodes, true
+}
+
+// traverseStyledNodes traverses the nodes styled by the style of [from, to)
+// with the ranges that style only each node.
+func traverseStyledNodes(
+	node *index.Node[*crdt.TreeNode],
+	offset, from, to int,
+	callback func(node *crdt.TreeNode, from, to int),
+) {
+	pos := offset
+	for _, child := range node.Children() {
+		start, end := pos, pos+child.PaddedLength()
+		pos = end
+		if end <= from || to <= start {
+			continue
+		}
+
+		if child.IsText() {
+			callback(child.Value, max(from, start), min(to, end))
+			continue
+		}
+
+		if from <= start || end <= to {
+			callback(child.Value, start, start+1)
+		}
+		traverseStyledNodes(child, start+1, from, to, callback)
+	}
+}
+
+// toJSONTreeNode converts the given tree node to json.TreeNode.
+func toJSONTreeNode(node *crdt.TreeNode) json.TreeNode {
+	if node.IsText() {
+		return json.TreeNode{
+			Type:  index.DefaultTextType,
+			Value: node.Value,
+		}
+	}
+
+	converted := json.TreeNode{Type: node.Type()}
+	if node.Attrs != nil && node.Attrs.Len() > 0 {
+		converted.Attributes = node.Attrs.Elements()
+	}
+	for _, child := range node.Index.Children() {
+		converted.Children = append(converted.Children, toJSONTreeNode(child.Value))
+	}
+
+	return converted
+}
+
+// treeNodeSize returns the size of the given node in the index of the tree.
+func treeNodeSize(node *json.TreeNode) int {
+	if node.Type == index.DefaultTextType {
+		return utf16Len(node.Value)
+	}
+
+	size := 2
+	for i := range node.Children {
+		size += treeNodeSize(&node.Children[i])
+	}
+	return size
+}
+
+// rebaseRange adjusts [from, to) against the remote edit that removed
+// [removedFrom, removedTo) and then inserted the content of the given length
+// at the given index.
+func rebaseRange(from, to, removedFrom, removedTo, at, length int) (int, int) {
+	rebaseIndex := func(idx int) int {
+		if idx >= removedTo {
+			return idx - (removedTo - removedFrom)
+		}
+		if idx > removedFrom {
+			return removedFrom
+		}
+		return idx
+	}
+	from, to = rebaseIndex(from), rebaseIndex(to)
+
+	if length > 0 {
+		if at <= from {
+			from += length
+		}
+		if at < to {
+			to += length
+		}
+	}
+
+	return from, max(from, to)
+}
+
+// clampRange clamps [from, to) to [0, length].
+func clampRange(from, to, length int) (int, int) {
+	from = max(0, min(from, length))
+	to = max(from, min(to, length))
+	return from, to
+}
+
+// isAlive returns whether the element of the given creation time exists and
+// is not removed.
+func isAlive(root *crdt.Root, createdAt *time.Ticket) bool {
+	elem := root.FindByCreatedAt(createdAt)
+	return elem != nil && elem.RemovedAt() == nil
+}
+
+// remapTicket returns the ticket mapped from the given ticket.
+func remapTicket(mapping map[string]*time.Ticket, ticket *time.Ticket) *time.Ticket {
+	if mapped, ok := mapping[ticket.Key()]; ok {
+		return mapped
+	}
+	return ticket
+}
+
+// negate returns the negated value of the given numeric value.
+func negate(value interface{}) (interface{}, bool) {
+	switch value := value.(type) {
+	case int32:
+		return -value, true
+	case int64:
+		return -value, true
+	case float64:
+		return -value, true
+	}
+	return nil, false
+}
+
+// utf16Len returns the length of the given string in UTF-16 code units.
+func utf16Len(value string) int {
+	return len(utf16.Encode([]rune(value)))
+}
+
+// sliceUTF16 returns the substring of [from, to) in UTF-16 code units.
+func sliceUTF16(value string, from, to int) string {
+	encoded := utf16.Encode([]rune(value))
+	return string(utf16.Decode(encoded[from:to]))
+}
@@ -0,0 +1,382 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestHistory(t *testing.T) {
+	t.Run("nothing to undo or redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.False(t, doc.History().CanUndo())
+		assert.False(t, doc.History().CanRedo())
+		assert.ErrorIs(t, doc.History().Undo(), document.ErrNothingToUndo)
+		assert.ErrorIs(t, doc.History().Redo(), document.ErrNothingToRedo)
+	})
+
+	t.Run("failed undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+
+		// The entry should be kept if undo fails.
+		doc.SetStatus(document.StatusRemoved)
+		assert.ErrorIs(t, doc.History().Undo(), document.ErrDocumentRemoved)
+		assert.True(t, doc.History().CanUndo())
+		assert.False(t, doc.History().CanRedo())
+
+		doc.SetStatus(document.StatusDetached)
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{}`, doc.Marshal())
+		assert.False(t, doc.History().CanUndo())
+
+		// The entry should be kept if redo fails.
+		doc.SetStatus(document.StatusRemoved)
+		assert.ErrorIs(t, doc.History().Redo(), document.ErrDocumentRemoved)
+		assert.True(t, doc.History().CanRedo())
+
+		doc.SetStatus(document.StatusDetached)
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"k1":"v1"}`, doc.Marshal())
+	})
+
+	t.Run("object undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("obj").SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("obj").SetString("k1", "v2")
+			root.GetObject("obj").SetNewArray("k2").AddInteger(1, 2)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetObject("obj").Delete("k2")
+			return nil
+		}))
+		assert.Equal(t, `{"obj":{"k1":"v2"}}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"obj":{"k1":"v2","k2":[1,2]}}`, doc.Marshal())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"obj":{"k1":"v1"}}`, doc.Marshal())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{}`, doc.Marshal())
+		assert.False(t, doc.History().CanUndo())
+
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"obj":{"k1":"v1"}}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"obj":{"k1":"v2","k2":[1,2]}}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"obj":{"k1":"v2"}}`, doc.Marshal())
+		assert.False(t, doc.History().CanRedo())
+
+		// Undo and redo after recreating the object should refer to the new one.
+		assert.NoError(t, doc.History().Undo())
+		assert.NoError(t, doc.History().Undo())
+		assert.NoError(t, doc.History().Undo())
+		assert.NoError(t, doc.History().Redo())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"obj":{"k1":"v2","k2":[1,2]}}`, doc.Marshal())
+	})
+
+	t.Run("array undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("k1").AddInteger(1, 2, 3)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k1").Delete(1)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k1").MoveAfterByIndex(1, 0)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k1").SetInteger(0, 4)
+			return nil
+		}))
+		assert.Equal(t, `{"k1":[4,1]}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"k1":[3,1]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"k1":[1,3]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"k1":[1,2,3]}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"k1":[1,3]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"k1":[3,1]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"k1":[4,1]}`, doc.Marshal())
+	})
+
+	t.Run("counter undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewCounter("cnt", crdt.IntegerCnt, 1)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetCounter("cnt").Increase(10)
+			return nil
+		}))
+		assert.Equal(t, `{"cnt":11}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"cnt":1}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"cnt":11}`, doc.Marshal())
+	})
+
+	t.Run("text undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "ABCD", map[string]string{"b": "1"})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Edit(1, 3, "12")
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Style(0, 1, map[string]string{"b": "2"})
+			return nil
+		}))
+		assert.Equal(
+			t,
+			`{"k1":[{"attrs":{"b":"2"},"val":"A"},{"val":"12"},{"attrs":{"b":"1"},"val":"D"}]}`,
+			doc.Marshal(),
+		)
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"k1":[{"attrs":{"b":"1"},"val":"A"},{"val":"12"},{"attrs":{"b":"1"},"val":"D"}]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"k1":[{"attrs":{"b":"1"},"val":"A"},{"attrs":{"b":"1"},"val":"BC"},{"attrs":{"b":"1"},"val":"D"}]}`, doc.Marshal())
+		assert.Equal(t, "ABCD", doc.Root().GetText("k1").String())
+
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, "A12D", doc.Root().GetText("k1").String())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(
+			t,
+			`{"k1":[{"attrs":{"b":"2"},"val":"A"},{"val":"12"},{"attrs":{"b":"1"},"val":"D"}]}`,
+			doc.Marshal(),
+		)
+	})
+
+	t.Run("text remove style undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "ABC", map[string]string{"b": "1"})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Style(2, 3, map[string]string{"i": "1"})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").RemoveStyle(0, 2, []string{"b"})
+			return nil
+		}))
+		assert.Equal(t, `{"k1":[{"val":"AB"},{"attrs":{"b":"1","i":"1"},"val":"C"}]}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"k1":[{"attrs":{"b":"1"},"val":"AB"},{"attrs":{"b":"1","i":"1"},"val":"C"}]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `{"k1":[{"attrs":{"b":"1"},"val":"AB"},{"attrs":{"b":"1"},"val":"C"}]}`, doc.Marshal())
+
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"k1":[{"attrs":{"b":"1"},"val":"AB"},{"attrs":{"b":"1","i":"1"},"val":"C"}]}`, doc.Marshal())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `{"k1":[{"val":"AB"},{"attrs":{"b":"1","i":"1"},"val":"C"}]}`, doc.Marshal())
+	})
+
+	t.Run("tree undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:     "p",
+					Children: []json.TreeNode{{Type: "text", Value: "ab"}},
+				}},
+			})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(1, 3, &json.TreeNode{Type: "text", Value: "xyz"}, 0)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Style(0, 1, map[string]string{"bold": "true"})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(0, 5, nil, 0)
+			return nil
+		}))
+		assert.Equal(t, `<doc></doc>`, doc.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `<doc><p bold="true">xyz</p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `<doc><p>xyz</p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `<doc><p>ab</p></doc>`, doc.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `<doc><p>xyz</p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `<doc><p bold="true">xyz</p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `<doc></doc>`, doc.Root().GetTree("t").ToXML())
+	})
+
+	t.Run("tree move undo/redo test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+				},
+			})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(2, 2, &json.TreeNode{Type: "text", Value: "x"}, 0)
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{3})
+			return nil
+		}))
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{1}, []int{0})
+			return nil
+		}))
+		assert.Equal(t, `<doc><p>c</p><p>b</p><p>ax</p></doc>`, doc.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `<doc><p>b</p><p>c</p><p>ax</p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `<doc><p>ax</p><p>b</p><p>c</p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Undo())
+		assert.Equal(t, `<doc><p>a</p><p>b</p><p>c</p></doc>`, doc.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `<doc><p>ax</p><p>b</p><p>c</p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `<doc><p>b</p><p>c</p><p>ax</p></doc>`, doc.Root().GetTree("t").ToXML())
+		assert.NoError(t, doc.History().Redo())
+		assert.Equal(t, `<doc><p>c</p><p>b</p><p>ax</p></doc>`, doc.Root().GetTree("t").ToXML())
+	})
+
+	t.Run("new change clears redo stack test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, doc.History().Undo())
+		assert.True(t, doc.History().CanRedo())
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.False(t, doc.History().CanRedo())
+	})
+
+	t.Run("max undo stack depth test", func(t *testing.T) {
+		doc := document.New("d1")
+		for i := 0; i < document.MaxUndoRedoStackDepth+10; i++ {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k1", i)
+				return nil
+			}))
+		}
+
+		for doc.History().CanUndo() {
+			assert.NoError(t, doc.History().Undo())
+		}
+		assert.Equal(t, `{"k1":9}`, doc.Marshal())
+	})
+
+	t.Run("rebase on remote text edit test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1")
+		docA.SetActor(actorA)
+		docB := document.New("d1")
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("k1").Edit(0, 0, "12345")
+			return nil
+		}))
+		packA := docA.CreateChangePack()
+		packA.VersionVector.Set(docB.ActorID(), docB.VersionVector().VersionOf(docB.ActorID()))
+		assert.NoError(t, docB.ApplyChangePack(packA))
+
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Edit(5, 5, "X")
+			return nil
+		}))
+		assert.Equal(t, "12345X", docB.Root().GetText("k1").String())
+
+		// Remote change inserts content before the local change.
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k1").Edit(0, 1, "AB")
+			return nil
+		}))
+		packA = docA.CreateChangePack()
+		packA.Changes = packA.Changes[1:]
+		packA.Checkpoint = docB.Checkpoint()
+		packA.VersionVector.Set(docB.ActorID(), docB.VersionVector().VersionOf(docB.ActorID()))
+		assert.NoError(t, docB.ApplyChangePack(packA))
+		assert.Equal(t, "AB2345X", docB.Root().GetText("k1").String())
+
+		assert.NoError(t, docB.History().Undo())
+		assert.Equal(t, "AB2345", docB.Root().GetText("k1").String())
+		assert.NoError(t, docB.History().Redo())
+		assert.Equal(t, "AB2345X", docB.Root().GetText("k1").String())
+	})
+}
@@ -24,6 +24,7 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 )
@@ -284,8 +285,23 @@ func (d *InternalDocument) applySnapshot(snapshot []byte, vector time.VersionVec
 	return nil
 }
 
-// ApplyChanges applies remote changes to the document.
+// ApplyChanges applies remote changes to the document. It returns the events
+// of the presences and the operations of the applied changes.
 func (d *InternalDocument) ApplyChanges(changes ...*change.Change) ([]DocEvent, error) {
+	return d.applyChanges(changes, false)
+}
+
+// ApplyChangesWithPatches applies remote changes to the document like
+// ApplyChanges, and also sets the JSON Patch of each change to the Patches of
+// its ChangeInfo. It costs more than ApplyChanges because the values of the
+// patches are encoded whenever an operation is executed.
+func (d *InternalDocument) ApplyChangesWithPatches(changes ...*change.Change) ([]DocEvent, error) {
+	return d.applyChanges(changes, true)
+}
+
+// applyChanges applies remote changes to the document. If withPatches is true,
+// the JSON Patch of each change is also set to the events.
+func (d *InternalDocument) applyChanges(changes []*change.Change, withPatches bool) ([]DocEvent, error) {
 	var events []DocEvent
 	for _, c := range changes {
 		if c.PresenceChange() != nil {
@@ -325,10 +341,30 @@ func (d *InternalDocument) ApplyChanges(changes ...*change.Change) ([]DocEvent,
 			}
 		}
 
-		if err := c.Execute(d.root, d.presences); err != nil {
+		var infos []OperationInfo
+		var patches *[]json.PatchOperation
+		if withPatches {
+			patches = &[]json.PatchOperation{}
+		}
+		if err := c.ExecuteWith(
+			d.root,
+			d.presences,
+			collectOperationInfos(d.root, &infos, patches),
+		); err != nil {
 			return nil, err
 		}
 
+		if len(infos) > 0 {
+			info := newChangeInfo(c, infos)
+			if patches != nil {
+				info.Patches = *patches
+			}
+			events = append(events, DocEvent{
+				Type:   RemoteChangeEvent,
+				Change: info,
+			})
+		}
+
 		d.changeID = d.changeID.SyncClocks(c.ID())
 	}
 
@@ -162,6 +162,26 @@ func (p *Array) AddNewArray() *Array {
 	return v.(*Array)
 }
 
+// AddNewObject adds a new object at the last.
+func (p *Array) AddNewObject(v ...any) *Object {
+	return p.addInternal(p.newObjectCreator(v...)).(*Object)
+}
+
+// AddNewText adds a new text at the last.
+func (p *Array) AddNewText() *Text {
+	return p.addInternal(p.newTextCreator()).(*Text)
+}
+
+// AddNewCounter adds a new counter at the last.
+func (p *Array) AddNewCounter(t crdt.CounterType, n any) *Counter {
+	return p.addInternal(p.newCounterCreator(t, n)).(*Counter)
+}
+
+// AddNewTree adds a new tree at the last.
+func (p *Array) AddNewTree(initialRoot ...*TreeNode) *Tree {
+	return p.addInternal(p.newTreeCreator(initialRoot...)).(*Tree)
+}
+
 // MoveBefore moves the given element to its new position before the given next element.
 func (p *Array) MoveBefore(nextCreatedAt, createdAt *time.Ticket) {
 	p.moveBeforeInternal(nextCreatedAt, createdAt)
@@ -195,6 +215,89 @@ func (p *Array) InsertIntegerAfter(index int, v int) *Array {
 	return p
 }
 
+// InsertAfter inserts the given value after the element of the given index.
+// The value can be any type that can be set by SetDynamicValue of Object.
+func (p *Array) InsertAfter(index int, v any) *Array {
+	p.insertAfterByIndex(index, p.newDynamicCreator(v))
+	return p
+}
+
+// InsertBefore inserts the given value before the element of the given index.
+func (p *Array) InsertBefore(index int, v any) *Array {
+	next := p.Get(index)
+	if next == nil {
+		panic("index out of bound")
+	}
+
+	prevCreatedAt, err := p.FindPrevCreatedAt(next.CreatedAt())
+	if err != nil {
+		panic(err)
+	}
+	p.insertAfterInternal(prevCreatedAt, p.newDynamicCreator(v))
+
+	return p
+}
+
+// InsertNullAfter inserts the null after the given previous element.
+func (p *Array) InsertNullAfter(index int) *Array {
+	return p.InsertAfter(index, nil)
+}
+
+// InsertBoolAfter inserts the given boolean after the given previous element.
+func (p *Array) InsertBoolAfter(index int, v bool) *Array {
+	return p.InsertAfter(index, v)
+}
+
+// InsertLongAfter inserts the given long after the given previous element.
+func (p *Array) InsertLongAfter(index int, v int64) *Array {
+	return p.InsertAfter(index, v)
+}
+
+// InsertDoubleAfter inserts the given double after the given previous element.
+func (p *Array) InsertDoubleAfter(index int, v float64) *Array {
+	return p.InsertAfter(index, v)
+}
+
+// InsertStringAfter inserts the given string after the given previous element.
+func (p *Array) InsertStringAfter(index int, v string) *Array {
+	return p.InsertAfter(index, v)
+}
+
+// InsertBytesAfter inserts the given bytes after the given previous element.
+func (p *Array) InsertBytesAfter(index int, v []byte) *Array {
+	return p.InsertAfter(index, v)
+}
+
+// InsertDateAfter inserts the given date after the given previous element.
+func (p *Array) InsertDateAfter(index int, v gotime.Time) *Array {
+	return p.InsertAfter(index, v)
+}
+
+// InsertNewObjectAfter inserts a new object after the given previous element.
+func (p *Array) InsertNewObjectAfter(index int, v ...any) *Object {
+	return p.insertAfterByIndex(index, p.newObjectCreator(v...)).(*Object)
+}
+
+// InsertNewArrayAfter inserts a new array after the given previous element.
+func (p *Array) InsertNewArrayAfter(index int, v ...any) *Array {
+	return p.insertAfterByIndex(index, p.newArrayCreator(v...)).(*Array)
+}
+
+// InsertNewTextAfter inserts a new text after the given previous element.
+func (p *Array) InsertNewTextAfter(index int) *Text {
+	return p.insertAfterByIndex(index, p.newTextCreator()).(*Text)
+}
+
+// InsertNewCounterAfter inserts a new counter after the given previous element.
+func (p *Array) InsertNewCounterAfter(index int, t crdt.CounterType, n any) *Counter {
+	return p.insertAfterByIndex(index, p.newCounterCreator(t, n)).(*Counter)
+}
+
+// InsertNewTreeAfter inserts a new tree after the given previous element.
+func (p *Array) InsertNewTreeAfter(index int, initialRoot ...*TreeNode) *Tree {
+	return p.insertAfterByIndex(index, p.newTreeCreator(initialRoot...)).(*Tree)
+}
+
 // Get element of the given index.
 func (p *Array) Get(idx int) crdt.Element {
 	if idx < 0 || p.Len() <= idx {
@@ -314,6 +417,73 @@ func (p *Array) SetInteger(idx int, value int) *Array {
 	return p
 }
 
+// Set replaces the element of the given index with the given value. The value
+// can be any type that can be set by SetDynamicValue of Object.
+func (p *Array) Set(idx int, v any) *Array {
+	p.setByIndex(idx, p.newDynamicCreator(v))
+	return p
+}
+
+// SetNull sets the null to the element of the given index.
+func (p *Array) SetNull(idx int) *Array {
+	return p.Set(idx, nil)
+}
+
+// SetBool sets the given boolean to the element of the given index.
+func (p *Array) SetBool(idx int, value bool) *Array {
+	return p.Set(idx, value)
+}
+
+// SetLong sets the given long to the element of the given index.
+func (p *Array) SetLong(idx int, value int64) *Array {
+	return p.Set(idx, value)
+}
+
+// SetDouble sets the given double to the element of the given index.
+func (p *Array) SetDouble(idx int, value float64) *Array {
+	return p.Set(idx, value)
+}
+
+// SetString sets the given string to the element of the given index.
+func (p *Array) SetString(idx int, value string) *Array {
+	return p.Set(idx, value)
+}
+
+// SetBytes sets the given bytes to the element of the given index.
+func (p *Array) SetBytes(idx int, value []byte) *Array {
+	return p.Set(idx, value)
+}
+
+// SetDate sets the given date to the element of the given index.
+func (p *Array) SetDate(idx int, value gotime.Time) *Array {
+	return p.Set(idx, value)
+}
+
+// SetNewObject sets a new object to the element of the given index.
+func (p *Array) SetNewObject(idx int, v ...any) *Object {
+	return p.setByIndex(idx, p.newObjectCreator(v...)).(*Object)
+}
+
+// SetNewArray sets a new array to the element of the given index.
+func (p *Array) SetNewArray(idx int, v ...any) *Array {
+	return p.setByIndex(idx, p.newArrayCreator(v...)).(*Array)
+}
+
+// SetNewText sets a new text to the element of the given index.
+func (p *Array) SetNewText(idx int) *Text {
+	return p.setByIndex(idx, p.newTextCreator()).(*Text)
+}
+
+// SetNewCounter sets a new counter to the element of the given index.
+func (p *Array) SetNewCounter(idx int, t crdt.CounterType, n any) *Counter {
+	return p.setByIndex(idx, p.newCounterCreator(t, n)).(*Counter)
+}
+
+// SetNewTree sets a new tree to the element of the given index.
+func (p *Array) SetNewTree(idx int, initialRoot ...*TreeNode) *Tree {
+	return p.setByIndex(idx, p.newTreeCreator(initialRoot...)).(*Tree)
+}
+
 // Delete deletes the element of the given index.
 func (p *Array) Delete(idx int) crdt.Element {
 	if idx < 0 || p.Len() <= idx {
@@ -364,14 +534,26 @@ func (p *Array) insertAfterInternal(
 		ticket,
 	))
 
-	if err = p.InsertAfter(prevCreatedAt, value); err != nil {
+	if err = p.Array.InsertAfter(prevCreatedAt, value); err != nil {
 		panic(err)
 	}
-	p.context.RegisterElement(value)
+	p.context.RegisterElement(p.Array, value)
 
 	return elem
 }
 
+func (p *Array) insertAfterByIndex(
+	index int,
+	creator func(ticket *time.Ticket) crdt.Element,
+) crdt.Element {
+	prev := p.Get(index)
+	if prev == nil {
+		panic("index out of bound")
+	}
+
+	return p.insertAfterInternal(prev.CreatedAt(), creator)
+}
+
 func (p *Array) moveBeforeInternal(nextCreatedAt, createdAt *time.Ticket) {
 	ticket := p.context.IssueTimeTicket()
 
@@ -428,16 +610,84 @@ func (p *Array) setByIndexInternal(
 		ticket,
 	))
 
-	_, err = p.Set(createdAt, value, ticket)
+	_, err = p.Array.Set(createdAt, value, ticket)
 	if err != nil {
 		panic(err)
 	}
 	// TODO(junseo): GC logic is not implemented here
 	// because there is no way to distinguish between old and new element with same `createdAt`.
-	p.context.RegisterElement(value)
+	p.context.RegisterElement(p.Array, value)
 	return elem
 }
 
+func (p *Array) setByIndex(
+	idx int,
+	creator func(ticket *time.Ticket) crdt.Element,
+) crdt.Element {
+	target := p.Get(idx)
+	if target == nil {
+		panic("index out of bound")
+	}
+
+	return p.setByIndexInternal(target.CreatedAt(), creator)
+}
+
+func (p *Array) newDynamicCreator(v any) func(ticket *time.Ticket) crdt.Element {
+	return func(ticket *time.Ticket) crdt.Element {
+		return toElement(p.context, buildCRDTElement(p.context, v, ticket, newBuildState()))
+	}
+}
+
+func (p *Array) newObjectCreator(v ...any) func(ticket *time.Ticket) crdt.Element {
+	return func(ticket *time.Ticket) crdt.Element {
+		if len(v) == 0 {
+			return NewObject(p.context, crdt.NewObject(crdt.NewElementRHT(), ticket))
+		}
+
+		if v[0] == nil || isJSONType(v[0]) || !(isStruct(v[0]) || isMapStringInterface(v[0])) {
+			panic("unsupported object type")
+		}
+		return toElement(p.context, buildCRDTElement(p.context, v[0], ticket, newBuildState()))
+	}
+}
+
+func (p *Array) newArrayCreator(v ...any) func(ticket *time.Ticket) crdt.Element {
+	return func(ticket *time.Ticket) crdt.Element {
+		if len(v) == 0 {
+			return NewArray(p.context, crdt.NewArray(crdt.NewRGATreeList(), ticket))
+		}
+
+		if v[0] == nil || !isArrayOrSlice(v[0]) {
+			panic("unsupported array type")
+		}
+		return toElement(p.context, buildCRDTElement(p.context, v[0], ticket, newBuildState()))
+	}
+}
+
+func (p *Array) newTextCreator() func(ticket *time.Ticket) crdt.Element {
+	return func(ticket *time.Ticket) crdt.Element {
+		text := NewText()
+		return text.Initialize(p.context, crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ticket))
+	}
+}
+
+func (p *Array) newCounterCreator(t crdt.CounterType, n any) func(ticket *time.Ticket) crdt.Element {
+	return func(ticket *time.Ticket) crdt.Element {
+		return toElement(p.context, buildCRDTElement(p.context, NewCounter(n, t), ticket, newBuildState()))
+	}
+}
+
+func (p *Array) newTreeCreator(initialRoot ...*TreeNode) func(ticket *time.Ticket) crdt.Element {
+	return func(ticket *time.Ticket) crdt.Element {
+		var root *TreeNode
+		if len(initialRoot) > 0 {
+			root = initialRoot[0]
+		}
+		tree := NewTree(root)
+		return tree.Initialize(p.context, crdt.NewTree(buildRoot(p.context, root, ticket), ticket))
+	}
+}
+
 // buildArrayElements return the element slice of the given array.
 // Because the type of the given array is `any`, it is necessary to type assertion.
 func buildArrayElements(
@@ -0,0 +1,212 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"fmt"
+	"reflect"
+	"unicode/utf16"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+)
+
+// Assign updates the members of this object to the fields of the given struct
+// or the entries of the given map with minimal operations. The members whose
+// values are not changed are kept, and only the changed ones are set. The
+// members of the fields that are empty with omitEmpty option, and the members
+// that are not in the map, are removed. Arrays are updated element by element,
+// counters are increased by the delta, and texts are edited in the changed
+// range. The members without matching fields are kept.
+func (p *Object) Assign(v any) {
+	value := reflect.ValueOf(v)
+	for value.Kind() == reflect.Pointer && !value.IsNil() {
+		value = value.Elem()
+	}
+
+	if !p.assignMembers(value) {
+		panic(fmt.Errorf("%s: %w", value.Type(), ErrDecodeTypeMismatch))
+	}
+}
+
+// assignMembers updates the members of this object to the given struct or
+// map. It returns false if the given value is neither a struct nor a map.
+func (p *Object) assignMembers(value reflect.Value) bool {
+	switch {
+	case value.Kind() == reflect.Struct && value.Type() != timeType:
+		for i := 0; i < value.NumField(); i++ {
+			field := value.Field(i)
+			name, options, ok := memberNameOf(value.Type().Field(i))
+			if !ok {
+				continue
+			}
+
+			if options.Contains("omitEmpty") && isEmptyValue(field) {
+				p.Delete(name)
+				continue
+			}
+			p.assignMember(name, field)
+		}
+		return true
+	case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+		for k := range p.Members() {
+			if !value.MapIndex(reflect.ValueOf(k).Convert(value.Type().Key())).IsValid() {
+				p.Delete(k)
+			}
+		}
+		iter := value.MapRange()
+		for iter.Next() {
+			p.assignMember(iter.Key().String(), iter.Value())
+		}
+		return true
+	}
+
+	return false
+}
+
+// assignMember updates the member of the given key to the given value.
+func (p *Object) assignMember(k string, value reflect.Value) {
+	if member := p.Object.Get(k); member != nil && assignElement(p, member, value) {
+		return
+	}
+	p.SetDynamicValue(k, value.Interface())
+}
+
+// assignArray updates the elements of this array to the given slice or array.
+// It returns false if the given value is neither a slice nor an array.
+func (p *Array) assignArray(value reflect.Value) bool {
+	if (value.Kind() != reflect.Slice && value.Kind() != reflect.Array) ||
+		value.Type().Elem().Kind() == reflect.Uint8 {
+		return false
+	}
+
+	for idx := 0; idx < value.Len(); idx++ {
+		item := value.Index(idx)
+		if idx >= p.Len() {
+			p.addInternal(p.newDynamicCreator(item.Interface()))
+			continue
+		}
+
+		if !assignElement(p, p.Get(idx), item) {
+			p.Set(idx, item.Interface())
+		}
+	}
+	for p.Len() > value.Len() {
+		p.Delete(p.Len() - 1)
+	}
+
+	return true
+}
+
+// assignElement updates the given element of the given container in place
+// to the given value. It returns false if the element should be replaced
+// with the value because the value has a different type or is changed.
+func assignElement(container crdt.Element, elem crdt.Element, value reflect.Value) bool {
+	if value.Kind() == reflect.Interface || value.Kind() == reflect.Pointer {
+		if value.IsNil() {
+			primitive, ok := elem.(*crdt.Primitive)
+			return ok && primitive.ValueType() == crdt.Null
+		}
+		return assignElement(container, elem, value.Elem())
+	}
+
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		return NewObject(contextOf(container), elem).assignMembers(value)
+	case *crdt.Array:
+		return NewArray(contextOf(container), elem).assignArray(value)
+	case *crdt.Counter:
+		return assignCounter(container, elem, value)
+	case *crdt.Text:
+		if value.Kind() != reflect.String {
+			return false
+		}
+		text := NewText().Initialize(contextOf(container), elem)
+		editText(text, value.String())
+		return true
+	}
+
+	// NOTE: The other elements are compared with the value by decoding them,
+	// and they are kept if they are equal.
+	decoded := reflect.New(value.Type())
+	if err := decodeElement("$", elem, decoded.Elem()); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(decoded.Elem().Interface(), value.Interface())
+}
+
+// assignCounter increases the given counter by the delta to the given
+// integer.
+func assignCounter(container crdt.Element, counter *crdt.Counter, value reflect.Value) bool {
+	var target int64
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		target = value.Int()
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32:
+		target = int64(value.Uint())
+	default:
+		return false
+	}
+
+	var current int64
+	switch n := counter.Value().(type) {
+	case int32:
+		current = int64(n)
+	case int64:
+		current = n
+	}
+
+	if delta := target - current; delta != 0 {
+		NewCounter(nil, counter.ValueType()).Initialize(contextOf(container), counter).Increase(delta)
+	}
+	return true
+}
+
+// editText edits the given text to the given content. Only the range between
+// the common prefix and suffix is edited.
+func editText(text *Text, content string) {
+	from := []rune(text.String())
+	to := []rune(content)
+
+	prefix := 0
+	for prefix < len(from) && prefix < len(to) && from[prefix] == to[prefix] {
+		prefix++
+	}
+	suffix := 0
+	for suffix < len(from)-prefix && suffix < len(to)-prefix &&
+		from[len(from)-1-suffix] == to[len(to)-1-suffix] {
+		suffix++
+	}
+	if prefix == len(from) && prefix == len(to) {
+		return
+	}
+
+	start := len(utf16.Encode(from[:prefix]))
+	end := len(utf16.Encode(from[:len(from)-suffix]))
+	text.Edit(start, end, string(to[prefix:len(to)-suffix]))
+}
+
+// contextOf returns the change context of the given container.
+func contextOf(container crdt.Element) *change.Context {
+	switch container := container.(type) {
+	case *Object:
+		return container.context
+	case *Array:
+		return container.context
+	}
+	panic("unsupported type")
+}
@@ -0,0 +1,268 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"errors"
+	"fmt"
+	"reflect"
+	"strconv"
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+)
+
+var (
+	// ErrInvalidDecodeTarget is returned when the target of Decode is not a
+	// non-nil pointer.
+	ErrInvalidDecodeTarget = errors.New("decode target should be a non-nil pointer")
+
+	// ErrDecodeTypeMismatch is returned when an element cannot be decoded
+	// into the type of the target.
+	ErrDecodeTypeMismatch = errors.New("element cannot be decoded into the type")
+)
+
+var timeType = reflect.TypeOf(gotime.Time{})
+
+// Decode decodes this object into the value pointed to by the given pointer.
+// The members of the object are matched with the fields of structs by the
+// "yorkie" tags in the same way as they are built from structs. Texts are
+// decoded into strings, trees into their XML, and counters into numbers. The
+// members without matching fields are ignored.
+func (p *Object) Decode(v any) error {
+	target := reflect.ValueOf(v)
+	if target.Kind() != reflect.Pointer || target.IsNil() {
+		return ErrInvalidDecodeTarget
+	}
+
+	return decodeElement("$", p.Object, target.Elem())
+}
+
+// decodeElement decodes the given element of the given path into the given
+// value.
+func decodeElement(path string, elem crdt.Element, value reflect.Value) error {
+	if primitive, ok := elem.(*crdt.Primitive); ok && primitive.ValueType() == crdt.Null {
+		value.SetZero()
+		return nil
+	}
+
+	switch value.Kind() {
+	case reflect.Pointer:
+		if value.IsNil() {
+			value.Set(reflect.New(value.Type().Elem()))
+		}
+		return decodeElement(path, elem, value.Elem())
+	case reflect.Interface:
+		if value.NumMethod() != 0 {
+			break
+		}
+		value.Set(reflect.ValueOf(toGoValue(elem)))
+		return nil
+	}
+
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		return decodeObject(path, elem, value)
+	case *crdt.Array:
+		return decodeArray(path, elem, value)
+	case *crdt.Text:
+		if value.Kind() == reflect.String {
+			value.SetString(elem.String())
+			return nil
+		}
+	case *crdt.Tree:
+		if value.Kind() == reflect.String {
+			value.SetString(elem.ToXML())
+			return nil
+		}
+	case *crdt.Counter:
+		if setNumber(value, elem.Value()) {
+			return nil
+		}
+	case *crdt.Primitive:
+		if decodePrimitive(elem, value) {
+			return nil
+		}
+	}
+
+	return fmt.Errorf("%s: %s: %w", path, value.Type(), ErrDecodeTypeMismatch)
+}
+
+// decodeObject decodes the given object into the given struct or map.
+func decodeObject(path string, obj *crdt.Object, value reflect.Value) error {
+	switch {
+	case value.Kind() == reflect.Struct && value.Type() != timeType:
+		for i := 0; i < value.NumField(); i++ {
+			name, _, ok := memberNameOf(value.Type().Field(i))
+			if !ok {
+				continue
+			}
+
+			member := obj.Get(name)
+			if member == nil {
+				continue
+			}
+			if err := decodeElement(path+"."+name, member, value.Field(i)); err != nil {
+				return err
+			}
+		}
+		return nil
+	case value.Kind() == reflect.Map && value.Type().Key().Kind() == reflect.String:
+		if value.IsNil() {
+			value.Set(reflect.MakeMap(value.Type()))
+		}
+		for k, member := range obj.Members() {
+			item := reflect.New(value.Type().Elem()).Elem()
+			if err := decodeElement(path+"."+k, member, item); err != nil {
+				return err
+			}
+			value.SetMapIndex(reflect.ValueOf(k).Convert(value.Type().Key()), item)
+		}
+		return nil
+	}
+
+	return fmt.Errorf("%s: %s: %w", path, value.Type(), ErrDecodeTypeMismatch)
+}
+
+// decodeArray decodes the given array into the given slice or array.
+func decodeArray(path string, array *crdt.Array, value reflect.Value) error {
+	elems := array.Elements()
+
+	switch {
+	case value.Kind() == reflect.Slice && value.Type().Elem().Kind() != reflect.Uint8:
+		value.Set(reflect.MakeSlice(value.Type(), len(elems), len(elems)))
+	case value.Kind() == reflect.Array && value.Len() >= len(elems):
+		value.SetZero()
+	default:
+		return fmt.Errorf("%s: %s: %w", path, value.Type(), ErrDecodeTypeMismatch)
+	}
+
+	for idx, elem := range elems {
+		if err := decodeElement(path+"."+strconv.Itoa(idx), elem, value.Index(idx)); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// decodePrimitive decodes the given primitive into the given value. It
+// returns false if the type of the value does not match the primitive.
+func decodePrimitive(primitive *crdt.Primitive, value reflect.Value) bool {
+	switch primitive.ValueType() {
+	case crdt.Boolean:
+		if value.Kind() == reflect.Bool {
+			value.SetBool(primitive.Value().(bool))
+			return true
+		}
+	case crdt.Integer, crdt.Long, crdt.Double:
+		return setNumber(value, primitive.Value())
+	case crdt.String:
+		if value.Kind() == reflect.String {
+			value.SetString(primitive.Value().(string))
+			return true
+		}
+	case crdt.Bytes:
+		if value.Kind() == reflect.Slice && value.Type().Elem().Kind() == reflect.Uint8 {
+			value.SetBytes(primitive.Value().([]byte))
+			return true
+		}
+	case crdt.Date:
+		if value.Type() == timeType {
+			value.Set(reflect.ValueOf(primitive.Value()))
+			return true
+		}
+	}
+
+	return false
+}
+
+// setNumber sets the given number to the given value. Integers can be set to
+// integers and floats, and floats can only be set to floats.
+func setNumber(value reflect.Value, number any) bool {
+	var n int64
+	switch number := number.(type) {
+	case int32:
+		n = int64(number)
+	case int64:
+		n = number
+	case float64:
+		if value.Kind() == reflect.Float32 || value.Kind() == reflect.Float64 {
+			value.SetFloat(number)
+			return true
+		}
+		return false
+	default:
+		return false
+	}
+
+	switch value.Kind() {
+	case reflect.Int, reflect.Int8, reflect.Int16, reflect.Int32, reflect.Int64:
+		value.SetInt(n)
+	case reflect.Uint, reflect.Uint8, reflect.Uint16, reflect.Uint32, reflect.Uint64:
+		value.SetUint(uint64(n))
+	case reflect.Float32, reflect.Float64:
+		value.SetFloat(float64(n))
+	default:
+		return false
+	}
+	return true
+}
+
+// toGoValue returns the Go value of the given element. Objects are converted
+// into maps, and arrays into slices.
+func toGoValue(elem crdt.Element) any {
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		members := make(map[string]any)
+		for k, member := range elem.Members() {
+			members[k] = toGoValue(member)
+		}
+		return members
+	case *crdt.Array:
+		var elems []any
+		for _, child := range elem.Elements() {
+			elems = append(elems, toGoValue(child))
+		}
+		return elems
+	case *crdt.Text:
+		return elem.String()
+	case *crdt.Tree:
+		return elem.ToXML()
+	case *crdt.Counter:
+		return elem.Value()
+	case *crdt.Primitive:
+		return elem.Value()
+	}
+
+	return nil
+}
+
+// memberNameOf returns the name of the member of the given struct field and
+// the options of its tag. It returns false if the field is unexported or has
+// the tag "yorkie:-".
+func memberNameOf(field reflect.StructField) (string, tagOptions, bool) {
+	tag := field.Tag.Get("yorkie")
+	if !field.IsExported() || tag == "-" {
+		return "", "", false
+	}
+
+	name, options := parseTag(tag)
+	if !isValidTag(name) {
+		name = field.Name
+	}
+	return name, options, true
+}
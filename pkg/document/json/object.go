@@ -17,7 +17,9 @@
 package json
 
 import (
+	"fmt"
 	"reflect"
+	"strconv"
 	"strings"
 	gotime "time"
 	"unicode"
@@ -325,6 +327,190 @@ func (p *Object) GetTree(k string) *Tree {
 	}
 }
 
+// GetByPath returns the element of the given path, e.g. "$.a.b[2].c". The
+// path starts with "$" which represents this object. It returns nil if there
+// is no element of the given path.
+func (p *Object) GetByPath(path string) crdt.Element {
+	subPaths, err := crdt.SplitPath(path)
+	if err != nil {
+		panic(err)
+	}
+
+	var elem crdt.Element = p
+	for _, subPath := range subPaths[1:] {
+		if elem = getChild(elem, subPath); elem == nil {
+			return nil
+		}
+	}
+
+	return elem
+}
+
+// GetObjectByPath returns Object of the given path.
+func (p *Object) GetObjectByPath(path string) *Object {
+	elem := p.GetByPath(path)
+	if elem == nil {
+		return nil
+	}
+
+	obj, ok := elem.(*Object)
+	if !ok {
+		panic("unsupported type")
+	}
+	return obj
+}
+
+// GetArrayByPath returns Array of the given path.
+func (p *Object) GetArrayByPath(path string) *Array {
+	elem := p.GetByPath(path)
+	if elem == nil {
+		return nil
+	}
+
+	array, ok := elem.(*Array)
+	if !ok {
+		panic("unsupported type")
+	}
+	return array
+}
+
+// GetTextByPath returns Text of the given path.
+func (p *Object) GetTextByPath(path string) *Text {
+	elem := p.GetByPath(path)
+	if elem == nil {
+		return nil
+	}
+
+	text, ok := elem.(*Text)
+	if !ok {
+		panic("unsupported type")
+	}
+	return text
+}
+
+// GetCounterByPath returns Counter of the given path.
+func (p *Object) GetCounterByPath(path string) *Counter {
+	elem := p.GetByPath(path)
+	if elem == nil {
+		return nil
+	}
+
+	counter, ok := elem.(*Counter)
+	if !ok {
+		panic("unsupported type")
+	}
+	return counter
+}
+
+// GetTreeByPath returns Tree of the given path.
+func (p *Object) GetTreeByPath(path string) *Tree {
+	elem := p.GetByPath(path)
+	if elem == nil {
+		return nil
+	}
+
+	tree, ok := elem.(*Tree)
+	if !ok {
+		panic("unsupported type")
+	}
+	return tree
+}
+
+// SetByPath sets the given value to the given path. If the parent of the path
+// is an object, the value is set to the key of the object. If the parent is
+// an array, the element of the index is replaced with the value.
+func (p *Object) SetByPath(path string, v any) *Object {
+	parent, subPath := p.findParentByPath(path)
+
+	switch parent := parent.(type) {
+	case *Object:
+		parent.SetDynamicValue(subPath, v)
+	case *Array:
+		parent.Set(parseIndex(path, subPath), v)
+	default:
+		panic("unsupported type")
+	}
+
+	return p
+}
+
+// DeleteByPath deletes the element of the given path. It returns nil if there
+// is no element of the given path.
+func (p *Object) DeleteByPath(path string) crdt.Element {
+	parent, subPath := p.findParentByPath(path)
+
+	switch parent := parent.(type) {
+	case *Object:
+		return parent.Delete(subPath)
+	case *Array:
+		return parent.Delete(parseIndex(path, subPath))
+	default:
+		panic("unsupported type")
+	}
+}
+
+// findParentByPath returns the parent of the element of the given path and
+// the last sub path of the element.
+func (p *Object) findParentByPath(path string) (crdt.Element, string) {
+	subPaths, err := crdt.SplitPath(path)
+	if err != nil {
+		panic(err)
+	}
+	if len(subPaths) < 2 {
+		panic(fmt.Errorf("%s: %w", path, crdt.ErrInvalidPath))
+	}
+
+	var parent crdt.Element = p
+	for _, subPath := range subPaths[1 : len(subPaths)-1] {
+		if parent = getChild(parent, subPath); parent == nil {
+			panic(fmt.Errorf("%s: %w", path, crdt.ErrChildNotFound))
+		}
+	}
+
+	return parent, subPaths[len(subPaths)-1]
+}
+
+// getChild returns the child of the given container of the given sub path.
+// It returns nil if the container is not an object or an array, or there is
+// no child of the given sub path.
+func getChild(container crdt.Element, subPath string) crdt.Element {
+	switch container := container.(type) {
+	case *Object:
+		if child := container.Object.Get(subPath); child != nil {
+			return toProxy(container.context, child)
+		}
+	case *Array:
+		idx, err := strconv.Atoi(subPath)
+		if err != nil {
+			return nil
+		}
+		if child := container.Get(idx); child != nil {
+			return toProxy(container.context, child)
+		}
+	}
+
+	return nil
+}
+
+// toProxy converts the given element to the corresponding json.Element if it
+// is not a proxy yet.
+func toProxy(ctx *change.Context, elem crdt.Element) crdt.Element {
+	switch elem.(type) {
+	case *Object, *Array, *Text, *Counter, *Tree:
+		return elem
+	}
+	return toElement(ctx, elem)
+}
+
+// parseIndex parses the given sub path of the given path as an array index.
+func parseIndex(path, subPath string) int {
+	idx, err := strconv.Atoi(subPath)
+	if err != nil {
+		panic(fmt.Errorf("%s: %w", path, crdt.ErrInvalidPath))
+	}
+	return idx
+}
+
 func (p *Object) setInternal(
 	k string,
 	creator func(ticket *time.Ticket) crdt.Element,
@@ -339,7 +525,7 @@ func (p *Object) setInternal(
 	}
 
 	removed := p.Set(k, value)
-	p.context.RegisterElement(value)
+	p.context.RegisterElement(p.Object, value)
 	if removed != nil {
 		p.context.RegisterRemovedElementPair(p, removed)
 	}
@@ -388,26 +574,15 @@ func buildObjectMembersFromValue(
 	members := make(map[string]crdt.Element)
 	for i := 0; i < value.NumField(); i++ {
 		field := value.Field(i)
-		fieldType := value.Type().Field(i)
-		tag := fieldType.Tag.Get("yorkie")
-
-		if !field.CanInterface() || tag == "-" {
+		name, options, ok := memberNameOf(value.Type().Field(i))
+		if !ok || !field.CanInterface() {
 			continue
 		}
 
-		name, options := parseTag(tag)
-		if !isValidTag(name) {
-			name = ""
-		}
-
 		if options.Contains("omitEmpty") && isEmptyValue(field) {
 			continue
 		}
 
-		if name == "" {
-			name = fieldType.Name
-		}
-
 		ticket := context.IssueTimeTicket()
 		members[name] = buildCRDTElement(context, value.Field(i).Interface(), ticket, stat)
 	}
@@ -0,0 +1,558 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"bytes"
+	gojson "encoding/json"
+	"errors"
+	"fmt"
+	"reflect"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+)
+
+var (
+	// ErrInvalidPatch is returned when the given patch is malformed or cannot
+	// be applied to the document.
+	ErrInvalidPatch = errors.New("invalid patch")
+
+	// ErrUnsupportedPatchTarget is returned when the given patch targets a
+	// text, a tree or a counter, which cannot be changed as JSON values.
+	ErrUnsupportedPatchTarget = errors.New("patch cannot target text, tree or counter")
+
+	// ErrPatchTestFailed is returned when the "test" operation of the given
+	// patch fails.
+	ErrPatchTestFailed = errors.New("patch test failed")
+)
+
+// The types of the operations of JSON Patch(RFC 6902).
+const (
+	PatchAdd     = "add"
+	PatchRemove  = "remove"
+	PatchReplace = "replace"
+	PatchMove    = "move"
+	PatchCopy    = "copy"
+	PatchTest    = "test"
+)
+
+// PatchOperation is an operation of JSON Patch(RFC 6902). Path and From are
+// JSON Pointers(RFC 6901) from the root of the document, e.g. "/todos/3".
+type PatchOperation struct {
+	Op    string            `json:"op"`
+	Path  string            `json:"path"`
+	From  string            `json:"from,omitempty"`
+	Value gojson.RawMessage `json:"value,omitempty"`
+}
+
+// pointerUnescaper unescapes the escaped characters of JSON Pointer.
+var pointerUnescaper = strings.NewReplacer("~1", "/", "~0", "~")
+
+// pointerEscaper escapes the characters that have special meanings in JSON
+// Pointer.
+var pointerEscaper = strings.NewReplacer("~", "~0", "/", "~1")
+
+// ToPointer returns the JSON Pointer of the given sub paths from the root,
+// e.g. ["$", "todos", "3"] to "/todos/3". The first sub path is the root "$"
+// and is omitted.
+func ToPointer(subPaths ...string) string {
+	if len(subPaths) == 0 {
+		return ""
+	}
+
+	sb := strings.Builder{}
+	for _, subPath := range subPaths[1:] {
+		sb.WriteString("/" + pointerEscaper.Replace(subPath))
+	}
+	return sb.String()
+}
+
+// parsePointer parses the given JSON Pointer into the reference tokens. The
+// empty pointer refers to the root and has no tokens.
+func parsePointer(pointer string) ([]string, error) {
+	if pointer == "" {
+		return nil, nil
+	}
+	if !strings.HasPrefix(pointer, "/") {
+		return nil, fmt.Errorf("pointer %q should start with /: %w", pointer, ErrInvalidPatch)
+	}
+
+	tokens := strings.Split(pointer[1:], "/")
+	for i, token := range tokens {
+		tokens[i] = pointerUnescaper.Replace(token)
+	}
+	return tokens, nil
+}
+
+// ApplyPatch applies the given JSON Patch(RFC 6902) to this object. "add",
+// "remove" and "replace" are applied as Set, Add, Remove and ArraySet, "move"
+// in the same array as Move, and "move" between containers and "copy" as
+// Remove and Set or Add of the copied value. Texts, trees and counters cannot
+// be targeted because they are not JSON values. If it returns an error, the
+// updater should return the error to discard the applied operations.
+func (p *Object) ApplyPatch(ops []PatchOperation) error {
+	for _, op := range ops {
+		if err := p.applyPatchOperation(op); err != nil {
+			return fmt.Errorf("%s %q: %w", op.Op, op.Path, err)
+		}
+	}
+
+	return nil
+}
+
+// ApplyMergePatch applies the given JSON Merge Patch(RFC 7396) to this
+// object. The members of null are removed, the members of objects are merged
+// recursively, and the others are set. Texts, trees and counters cannot be
+// targeted because they are not JSON values.
+func (p *Object) ApplyMergePatch(patch []byte) error {
+	value, err := parsePatchValue(patch)
+	if err != nil {
+		return err
+	}
+
+	members, ok := value.(map[string]any)
+	if !ok {
+		return fmt.Errorf("merge patch should be an object: %w", ErrInvalidPatch)
+	}
+	return p.mergeMembers("", members)
+}
+
+// applyPatchOperation applies the given operation of JSON Patch.
+func (p *Object) applyPatchOperation(op PatchOperation) error {
+	switch op.Op {
+	case PatchAdd, PatchReplace:
+		value, err := parsePatchValue(op.Value)
+		if err != nil {
+			return err
+		}
+		return p.putByPointer(op.Path, value, op.Op == PatchReplace)
+	case PatchRemove:
+		return p.removeByPointer(op.Path)
+	case PatchMove:
+		if op.From == op.Path {
+			return nil
+		}
+		if strings.HasPrefix(op.Path, op.From+"/") {
+			return fmt.Errorf("cannot move %q into its child: %w", op.From, ErrInvalidPatch)
+		}
+
+		moved, err := p.moveInArray(op.From, op.Path)
+		if err != nil || moved {
+			return err
+		}
+
+		value, err := p.copyByPointer(op.From)
+		if err != nil {
+			return err
+		}
+		if err := p.removeByPointer(op.From); err != nil {
+			return err
+		}
+		return p.putByPointer(op.Path, value, false)
+	case PatchCopy:
+		value, err := p.copyByPointer(op.From)
+		if err != nil {
+			return err
+		}
+		return p.putByPointer(op.Path, value, false)
+	case PatchTest:
+		elem, err := p.findByPointer(op.Path)
+		if err != nil {
+			return err
+		}
+		if !equalJSON([]byte(elem.Marshal()), op.Value) {
+			return ErrPatchTestFailed
+		}
+		return nil
+	}
+
+	return fmt.Errorf("unknown operation %q: %w", op.Op, ErrInvalidPatch)
+}
+
+// putByPointer adds the given value to the given JSON Pointer. If replace is
+// true, the element of the pointer should exist and is replaced.
+func (p *Object) putByPointer(pointer string, value any, replace bool) error {
+	if pointer == "" {
+		members, ok := value.(map[string]any)
+		if !ok {
+			return fmt.Errorf("root should be an object: %w", ErrInvalidPatch)
+		}
+		return p.replaceMembers(members)
+	}
+
+	parent, token, err := p.findParentByPointer(pointer)
+	if err != nil {
+		return err
+	}
+
+	switch parent := parent.(type) {
+	case *Object:
+		member := parent.Object.Get(token)
+		if member == nil && replace {
+			return fmt.Errorf("member %q not found: %w", token, ErrInvalidPatch)
+		}
+		if member != nil && !isPatchable(member) {
+			return ErrUnsupportedPatchTarget
+		}
+		parent.SetDynamicValue(token, value)
+	case *Array:
+		if replace {
+			idx, err := parseArrayIndex(token, parent.Len()-1)
+			if err != nil {
+				return err
+			}
+			if !isPatchable(parent.Get(idx)) {
+				return ErrUnsupportedPatchTarget
+			}
+			parent.Set(idx, value)
+			return nil
+		}
+
+		idx := parent.Len()
+		if token != "-" {
+			if idx, err = parseArrayIndex(token, parent.Len()); err != nil {
+				return err
+			}
+		}
+		if idx == parent.Len() {
+			parent.addInternal(parent.newDynamicCreator(value))
+		} else {
+			parent.InsertBefore(idx, value)
+		}
+	}
+
+	return nil
+}
+
+// removeByPointer removes the element of the given JSON Pointer.
+func (p *Object) removeByPointer(pointer string) error {
+	if pointer == "" {
+		return fmt.Errorf("cannot remove root: %w", ErrInvalidPatch)
+	}
+
+	parent, token, err := p.findParentByPointer(pointer)
+	if err != nil {
+		return err
+	}
+
+	switch parent := parent.(type) {
+	case *Object:
+		member := parent.Object.Get(token)
+		if member == nil {
+			return fmt.Errorf("member %q not found: %w", token, ErrInvalidPatch)
+		}
+		if !isPatchable(member) {
+			return ErrUnsupportedPatchTarget
+		}
+		parent.Delete(token)
+	case *Array:
+		idx, err := parseArrayIndex(token, parent.Len()-1)
+		if err != nil {
+			return err
+		}
+		if !isPatchable(parent.Get(idx)) {
+			return ErrUnsupportedPatchTarget
+		}
+		parent.Delete(idx)
+	}
+
+	return nil
+}
+
+// moveInArray moves the element of the given from pointer to the given path
+// pointer if both of them are in the same array. It returns false if they are
+// not in the same array.
+func (p *Object) moveInArray(from, path string) (bool, error) {
+	fromParent, fromToken, err := p.findParentByPointer(from)
+	if err != nil {
+		return false, err
+	}
+	pathParent, pathToken, err := p.findParentByPointer(path)
+	if err != nil {
+		return false, err
+	}
+
+	array, ok := fromParent.(*Array)
+	if !ok || pathParent.CreatedAt().Compare(array.CreatedAt()) != 0 {
+		return false, nil
+	}
+
+	fromIdx, err := parseArrayIndex(fromToken, array.Len()-1)
+	if err != nil {
+		return false, err
+	}
+	target := array.Get(fromIdx)
+	if !isPatchable(target) {
+		return false, ErrUnsupportedPatchTarget
+	}
+
+	// NOTE: The index of the path is the index after the element is removed
+	// from the array.
+	var others []crdt.Element
+	for idx, elem := range array.Elements() {
+		if idx != fromIdx {
+			others = append(others, elem)
+		}
+	}
+	toIdx := len(others)
+	if pathToken != "-" {
+		if toIdx, err = parseArrayIndex(pathToken, len(others)); err != nil {
+			return false, err
+		}
+	}
+
+	switch {
+	case toIdx == fromIdx:
+	case toIdx < len(others):
+		array.moveBeforeInternal(others[toIdx].CreatedAt(), target.CreatedAt())
+	default:
+		array.moveAfterInternal(others[len(others)-1].CreatedAt(), target.CreatedAt())
+	}
+
+	return true, nil
+}
+
+// copyByPointer returns the copied value of the element of the given JSON
+// Pointer. The element cannot have texts, trees and counters.
+func (p *Object) copyByPointer(pointer string) (any, error) {
+	elem, err := p.findByPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	if hasUnpatchable(toOriginal(elem)) {
+		return nil, ErrUnsupportedPatchTarget
+	}
+
+	return toGoValue(toOriginal(elem)), nil
+}
+
+// findByPointer returns the element of the given JSON Pointer.
+func (p *Object) findByPointer(pointer string) (crdt.Element, error) {
+	if pointer == "" {
+		return p, nil
+	}
+
+	parent, token, err := p.findParentByPointer(pointer)
+	if err != nil {
+		return nil, err
+	}
+	elem := getChild(parent, token)
+	if elem == nil {
+		return nil, fmt.Errorf("%q not found: %w", token, ErrInvalidPatch)
+	}
+	if !isPatchable(elem) {
+		return nil, ErrUnsupportedPatchTarget
+	}
+
+	return elem, nil
+}
+
+// findParentByPointer returns the parent container of the element of the
+// given JSON Pointer and the last reference token of the pointer.
+func (p *Object) findParentByPointer(pointer string) (crdt.Element, string, error) {
+	tokens, err := parsePointer(pointer)
+	if err != nil {
+		return nil, "", err
+	}
+	if len(tokens) == 0 {
+		return nil, "", fmt.Errorf("root has no parent: %w", ErrInvalidPatch)
+	}
+
+	var parent crdt.Element = p
+	for _, token := range tokens[:len(tokens)-1] {
+		if parent = getChild(parent, token); parent == nil {
+			return nil, "", fmt.Errorf("%q not found: %w", token, ErrInvalidPatch)
+		}
+		if !isPatchable(parent) {
+			return nil, "", ErrUnsupportedPatchTarget
+		}
+	}
+
+	switch parent.(type) {
+	case *Object, *Array:
+		return parent, tokens[len(tokens)-1], nil
+	}
+	return nil, "", fmt.Errorf("parent of %q is not a container: %w", pointer, ErrInvalidPatch)
+}
+
+// replaceMembers replaces the members of this object with the given members.
+func (p *Object) replaceMembers(members map[string]any) error {
+	for k, member := range p.Members() {
+		if !isPatchable(member) {
+			return ErrUnsupportedPatchTarget
+		}
+		if _, ok := members[k]; !ok {
+			p.Delete(k)
+		}
+	}
+
+	for _, k := range sortedKeys(members) {
+		p.SetDynamicValue(k, members[k])
+	}
+	return nil
+}
+
+// mergeMembers merges the given members of JSON Merge Patch into this object
+// of the given JSON Pointer.
+func (p *Object) mergeMembers(pointer string, patch map[string]any) error {
+	for _, k := range sortedKeys(patch) {
+		member := p.Object.Get(k)
+		if member != nil && !isPatchable(member) {
+			return fmt.Errorf("%q: %w", pointer+ToPointer("$", k), ErrUnsupportedPatchTarget)
+		}
+
+		switch value := patch[k].(type) {
+		case nil:
+			if member != nil {
+				p.Delete(k)
+			}
+		case map[string]any:
+			if obj, ok := member.(*crdt.Object); ok {
+				if err := NewObject(p.context, obj).mergeMembers(pointer+ToPointer("$", k), value); err != nil {
+					return err
+				}
+				continue
+			}
+			p.SetDynamicValue(k, removeNulls(value))
+		default:
+			p.SetDynamicValue(k, value)
+		}
+	}
+
+	return nil
+}
+
+// parsePatchValue parses the given JSON value of the patch into the value that
+// can be set by SetDynamicValue. Integers are parsed into int, and the other
+// numbers into float64.
+func parsePatchValue(data []byte) (any, error) {
+	if len(data) == 0 {
+		return nil, fmt.Errorf("missing value: %w", ErrInvalidPatch)
+	}
+
+	decoder := gojson.NewDecoder(bytes.NewReader(data))
+	decoder.UseNumber()
+
+	var value any
+	if err := decoder.Decode(&value); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidPatch)
+	}
+	return toDynamicValue(value), nil
+}
+
+// toDynamicValue converts the numbers in the given decoded JSON value.
+func toDynamicValue(value any) any {
+	switch value := value.(type) {
+	case gojson.Number:
+		if i, err := value.Int64(); err == nil {
+			return int(i)
+		}
+		f, _ := value.Float64()
+		return f
+	case map[string]any:
+		for k, v := range value {
+			value[k] = toDynamicValue(v)
+		}
+	case []any:
+		for i, v := range value {
+			value[i] = toDynamicValue(v)
+		}
+	}
+
+	return value
+}
+
+// removeNulls removes the members of null from the given object of JSON Merge
+// Patch recursively.
+func removeNulls(members map[string]any) map[string]any {
+	for k, v := range members {
+		switch v := v.(type) {
+		case nil:
+			delete(members, k)
+		case map[string]any:
+			members[k] = removeNulls(v)
+		}
+	}
+	return members
+}
+
+// parseArrayIndex parses the given reference token as an array index that is
+// not greater than the given last index.
+func parseArrayIndex(token string, last int) (int, error) {
+	idx, err := strconv.Atoi(token)
+	if err != nil || idx < 0 || idx > last || strconv.Itoa(idx) != token {
+		return 0, fmt.Errorf("invalid index %q: %w", token, ErrInvalidPatch)
+	}
+	return idx, nil
+}
+
+// isPatchable returns whether the given element can be changed by patches.
+func isPatchable(elem crdt.Element) bool {
+	switch elem.(type) {
+	case *Text, *Tree, *Counter, *crdt.Text, *crdt.Tree, *crdt.Counter:
+		return false
+	}
+	return true
+}
+
+// hasUnpatchable returns whether the given element or its descendants cannot
+// be changed by patches.
+func hasUnpatchable(elem crdt.Element) bool {
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		for _, member := range elem.Members() {
+			if hasUnpatchable(member) {
+				return true
+			}
+		}
+	case *crdt.Array:
+		for _, child := range elem.Elements() {
+			if hasUnpatchable(child) {
+				return true
+			}
+		}
+	default:
+		return !isPatchable(elem)
+	}
+
+	return false
+}
+
+// equalJSON returns whether the given JSON values are equal.
+func equalJSON(a, b []byte) bool {
+	var x, y any
+	if err := gojson.Unmarshal(a, &x); err != nil {
+		return false
+	}
+	if err := gojson.Unmarshal(b, &y); err != nil {
+		return false
+	}
+	return reflect.DeepEqual(x, y)
+}
+
+// sortedKeys returns the keys of the given map in order.
+func sortedKeys(m map[string]any) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
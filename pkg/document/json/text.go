@@ -17,11 +17,37 @@
 package json
 
 import (
+	gojson "encoding/json"
+	"maps"
+	"slices"
+	"unicode/utf16"
+
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/operations"
 )
 
+// TextRun is a run of the text that has the same attributes.
+type TextRun struct {
+	// Value is the content of this run.
+	Value string
+
+	// Attributes is the attributes of this run. If the run has no attributes,
+	// it is nil.
+	Attributes map[string]string
+}
+
+// DeltaOp is an operation of Quill Delta. Only one of Insert, Retain and
+// Delete is set. The attributes of the text are strings, so the attribute
+// whose value is not a string is stored as its JSON encoding, and the
+// attribute whose value is nil in Retain means that it is removed.
+type DeltaOp struct {
+	Insert     string         `json:"insert,omitempty"`
+	Retain     int            `json:"retain,omitempty"`
+	Delete     int            `json:"delete,omitempty"`
+	Attributes map[string]any `json:"attributes,omitempty"`
+}
+
 // Text represents a text in the document. As a proxy for the CRDT
 // text, it is used when the user manipulates the rich text from the outside.
 type Text struct {
@@ -141,3 +167,155 @@ func (p *Text) Style(from, to int, attributes map[string]string) *Text {
 
 	return p
 }
+
+// RemoveStyle removes the attributes of the given keys from the given range.
+func (p *Text) RemoveStyle(from, to int, attributesToRemove []string) *Text {
+	if from > to {
+		panic("from should be less than or equal to to")
+	}
+
+	if len(attributesToRemove) == 0 {
+		return p
+	}
+
+	fromPos, toPos, err := p.Text.CreateRange(from, to)
+	if err != nil {
+		panic(err)
+	}
+
+	ticket := p.context.IssueTimeTicket()
+	maxCreationMapByActor, pairs, err := p.Text.RemoveStyle(
+		fromPos,
+		toPos,
+		nil,
+		attributesToRemove,
+		ticket,
+		nil,
+	)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pair := range pairs {
+		p.context.RegisterGCPair(pair)
+	}
+
+	p.context.Push(operations.NewStyleRemove(
+		p.CreatedAt(),
+		fromPos,
+		toPos,
+		maxCreationMapByActor,
+		attributesToRemove,
+		ticket,
+	))
+
+	return p
+}
+
+// Runs returns the runs of this text. Adjacent nodes that have the same
+// attributes are merged into one run.
+func (p *Text) Runs() []TextRun {
+	var runs []TextRun
+	for _, node := range p.Nodes() {
+		if node.RemovedAt() != nil || node.Value().Len() == 0 {
+			continue
+		}
+
+		var attrs map[string]string
+		if node.Value().Attrs().Len() > 0 {
+			attrs = node.Value().Attrs().Elements()
+		}
+
+		if last := len(runs) - 1; last >= 0 && maps.Equal(runs[last].Attributes, attrs) {
+			runs[last].Value += node.Value().Value()
+			continue
+		}
+		runs = append(runs, TextRun{
+			Value:      node.Value().Value(),
+			Attributes: attrs,
+		})
+	}
+
+	return runs
+}
+
+// ToDelta returns the contents of this text as insert operations of Quill
+// Delta.
+func (p *Text) ToDelta() []DeltaOp {
+	var delta []DeltaOp
+	for _, run := range p.Runs() {
+		op := DeltaOp{Insert: run.Value}
+		if run.Attributes != nil {
+			op.Attributes = make(map[string]any, len(run.Attributes))
+			for k, v := range run.Attributes {
+				op.Attributes[k] = v
+			}
+		}
+		delta = append(delta, op)
+	}
+
+	return delta
+}
+
+// ApplyDelta applies the given Quill Delta to this text. The operations of
+// the delta are translated into Edit, Style and RemoveStyle of this text.
+func (p *Text) ApplyDelta(delta []DeltaOp) *Text {
+	idx := 0
+	for _, op := range delta {
+		attributes, attributesToRemove := toTextAttributes(op.Attributes)
+
+		switch {
+		case op.Insert != "":
+			if len(attributes) > 0 {
+				p.Edit(idx, idx, op.Insert, attributes)
+			} else {
+				p.Edit(idx, idx, op.Insert)
+			}
+			idx += len(utf16.Encode([]rune(op.Insert)))
+		case op.Delete > 0:
+			p.Edit(idx, idx+op.Delete, "")
+		case op.Retain > 0:
+			if len(attributes) > 0 {
+				p.Style(idx, idx+op.Retain, attributes)
+			}
+			if len(attributesToRemove) > 0 {
+				p.RemoveStyle(idx, idx+op.Retain, attributesToRemove)
+			}
+			idx += op.Retain
+		}
+	}
+
+	return p
+}
+
+// toTextAttributes converts the given attributes of Quill Delta to the
+// attributes of the text and the keys of the attributes to remove.
+func toTextAttributes(attrs map[string]any) (map[string]string, []string) {
+	attributes := make(map[string]string)
+	var attributesToRemove []string
+	for k, v := range attrs {
+		switch v := v.(type) {
+		case nil:
+			attributesToRemove = append(attributesToRemove, k)
+		default:
+			attributes[k] = toAttributeValue(v)
+		}
+	}
+	slices.Sort(attributesToRemove)
+
+	return attributes, attributesToRemove
+}
+
+// toAttributeValue converts the given value to the value of the attribute.
+// The value that is not a string is converted to its JSON encoding.
+func toAttributeValue(v any) string {
+	if str, ok := v.(string); ok {
+		return str
+	}
+
+	encoded, err := gojson.Marshal(v)
+	if err != nil {
+		panic(err)
+	}
+	return string(encoded)
+}
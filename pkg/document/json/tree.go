@@ -18,6 +18,7 @@ package json
 
 import (
 	"errors"
+	"unicode/utf16"
 
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
@@ -42,6 +43,10 @@ var (
 	ErrPathLenDiff = errors.New("both paths should have same length")
 	// ErrEmptyPath is returned when there's empty path
 	ErrEmptyPath = errors.New("path should not be empty")
+	// ErrMoveTextNode is returned when moving a text node
+	ErrMoveTextNode = errors.New("only element node can be moved")
+	// ErrMoveIntoItself is returned when moving a node into itself
+	ErrMoveIntoItself = errors.New("node cannot be moved into itself")
 )
 
 // TreeNode is a node of Tree.
@@ -221,6 +226,13 @@ func (t *Tree) Style(fromIdx, toIdx int, attributes map[string]string) bool {
 	}
 
 	ticket := t.context.IssueTimeTicket()
+	if err := t.validateSchema(func(tree *crdt.Tree, _ func() *time.Ticket) error {
+		_, _, err := tree.Style(fromPos, toPos, attributes, ticket, nil, nil)
+		return err
+	}); err != nil {
+		panic(err)
+	}
+
 	maxCreationMapByActor, pairs, err := t.Tree.Style(fromPos, toPos, attributes, ticket, nil, nil)
 	if err != nil {
 		panic(err)
@@ -283,11 +295,111 @@ func (t *Tree) RemoveStyle(fromIdx, toIdx int, attributesToRemove []string) bool
 	return true
 }
 
+// Move moves the element node at the given fromPath to the given toPath. The
+// node keeps its identity, so the edits inside the node made concurrently by
+// other clients are kept. If the node is moved by several clients
+// concurrently, the move executed last wins.
+func (t *Tree) Move(fromPath []int, toPath []int) bool {
+	if len(fromPath) == 0 || len(toPath) == 0 {
+		panic(ErrEmptyPath)
+	}
+
+	node := t.Root()
+	for _, offset := range fromPath {
+		children := node.Index.Children()
+		if node.IsText() || offset < 0 || offset >= len(children) {
+			panic(index.ErrUnreachablePath)
+		}
+		node = children[offset].Value
+	}
+	if node.IsText() {
+		panic(ErrMoveTextNode)
+	}
+
+	fromIdx, err := t.IndexTree.PathToIndex(fromPath)
+	if err != nil {
+		panic(err)
+	}
+	toIdx := fromIdx + node.Index.PaddedLength()
+	targetIdx, err := t.IndexTree.PathToIndex(toPath)
+	if err != nil {
+		panic(err)
+	}
+	if fromIdx < targetIdx && targetIdx < toIdx {
+		panic(ErrMoveIntoItself)
+	}
+	if targetIdx == fromIdx || targetIdx == toIdx {
+		return true
+	}
+
+	targetPos, err := t.Tree.FindPos(targetIdx)
+	if err != nil {
+		panic(err)
+	}
+
+	ticket := t.context.IssueTimeTicket()
+	pairs, err := t.Tree.Move(node.ID(), targetPos, ticket, nil)
+	if err != nil {
+		panic(err)
+	}
+
+	for _, pair := range pairs {
+		t.context.RegisterGCPair(pair)
+	}
+
+	t.context.Push(operations.NewTreeMove(
+		t.CreatedAt(),
+		node.ID(),
+		targetPos,
+		ticket,
+	))
+
+	return true
+}
+
 // Len returns the length of this tree.
 func (t *Tree) Len() int {
 	return t.IndexTree.Root().Len()
 }
 
+// Node returns the node of the given path. Each element of the path is the
+// offset of the child, and adjacent text nodes are regarded as one node. The
+// empty path represents the root node.
+func (t *Tree) Node(path []int) TreeNode {
+	node := toTreeNode(t.Root())
+	for _, offset := range path {
+		if offset < 0 || offset >= len(node.Children) {
+			panic(index.ErrUnreachablePath)
+		}
+		node = node.Children[offset]
+	}
+
+	return node
+}
+
+// Children returns the children of the node of the given path.
+func (t *Tree) Children(path []int) []TreeNode {
+	return t.Node(path).Children
+}
+
+// Attributes returns the attributes of the node of the given path. If the
+// node has no attributes, it returns nil.
+func (t *Tree) Attributes(path []int) map[string]string {
+	return t.Node(path).Attributes
+}
+
+// ToJSON returns the JSON encoding of this tree.
+func (t *Tree) ToJSON() string {
+	return t.Marshal()
+}
+
+// Traverse traverses the nodes of this tree except the root in pre-order. The
+// given callback is called with the node, the index of the position in front
+// of the node and the path of the node that can be passed to Node.
+func (t *Tree) Traverse(callback func(node TreeNode, idx int, path []int)) {
+	traverseTreeNode(toTreeNode(t.Root()), 0, nil, callback)
+}
+
 // edit edits the tree with the given nodes.
 func (t *Tree) edit(fromPos, toPos *crdt.TreePos, contents []*TreeNode, splitLevel int) bool {
 	ticket := t.context.IssueTimeTicket()
@@ -346,6 +458,22 @@ func (t *Tree) edit(fromPos, toPos *crdt.TreePos, contents []*TreeNode, splitLev
 	}
 
 	ticket = t.context.LastTimeTicket()
+	if err := t.validateSchema(func(tree *crdt.Tree, issueTimeTicket func() *time.Ticket) error {
+		var contents []*crdt.TreeNode
+		for _, node := range nodes {
+			clone, err := node.DeepCopy()
+			if err != nil {
+				return err
+			}
+			contents = append(contents, clone)
+		}
+
+		_, _, err := tree.Edit(fromPos, toPos, contents, splitLevel, ticket, issueTimeTicket, nil, nil)
+		return err
+	}); err != nil {
+		panic(err)
+	}
+
 	maxCreationMapByActor, pairs, err := t.Tree.Edit(
 		fromPos,
 		toPos,
@@ -377,6 +505,84 @@ func (t *Tree) edit(fromPos, toPos *crdt.TreePos, contents []*TreeNode, splitLev
 	return true
 }
 
+// validateSchema validates the result of the given edit with the schema of
+// the tree. The edit is applied to a copy of the tree, so the tree is not
+// changed even if the result violates the schema. The edit should issue time
+// tickets with the given function instead of the context.
+func (t *Tree) validateSchema(edit func(tree *crdt.Tree, issueTimeTicket func() *time.Ticket) error) error {
+	if t.Tree.Schema() == nil {
+		return nil
+	}
+
+	copied, err := t.Tree.DeepCopy()
+	if err != nil {
+		return err
+	}
+	tree := copied.(*crdt.Tree)
+
+	last := t.context.LastTimeTicket()
+	delimiter := last.Delimiter()
+	if err := edit(tree, func() *time.Ticket {
+		delimiter++
+		return time.NewTicket(last.Lamport(), delimiter, last.ActorID())
+	}); err != nil {
+		return err
+	}
+
+	return tree.ValidateSchema()
+}
+
+// toTreeNode converts the given CRDT-based tree node to TreeNode. The removed
+// descendants of the node are excluded, and adjacent text nodes, which are
+// split by editing, are merged into one.
+func toTreeNode(node *crdt.TreeNode) TreeNode {
+	if node.IsText() {
+		return TreeNode{
+			Type:  index.DefaultTextType,
+			Value: node.Value,
+		}
+	}
+
+	converted := TreeNode{Type: node.Type()}
+	if node.Attrs != nil && node.Attrs.Len() > 0 {
+		converted.Attributes = node.Attrs.Elements()
+	}
+	for _, child := range node.Index.Children() {
+		last := len(converted.Children) - 1
+		if child.Value.IsText() && last >= 0 && converted.Children[last].Type == index.DefaultTextType {
+			converted.Children[last].Value += child.Value.Value
+			continue
+		}
+		converted.Children = append(converted.Children, toTreeNode(child.Value))
+	}
+
+	return converted
+}
+
+// traverseTreeNode traverses the descendants of the given node in pre-order
+// and returns the size of them. The given index is the index of the first
+// position inside the node.
+func traverseTreeNode(
+	node TreeNode,
+	idx int,
+	path []int,
+	callback func(node TreeNode, idx int, path []int),
+) int {
+	size := 0
+	for offset, child := range node.Children {
+		childPath := append(append([]int{}, path...), offset)
+		callback(child, idx+size, childPath)
+
+		if child.Type == index.DefaultTextType {
+			size += len(utf16.Encode([]rune(child.Value)))
+		} else {
+			size += traverseTreeNode(child, idx+size+1, childPath, callback) + 2
+		}
+	}
+
+	return size
+}
+
 // buildRoot converts the given node to a CRDT-based tree node. If the given
 // node is nil, it creates a default root node.
 func buildRoot(ctx *change.Context, node *TreeNode, createdAt *time.Ticket) *crdt.TreeNode {
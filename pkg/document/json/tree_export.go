@@ -0,0 +1,362 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package json
+
+import (
+	"errors"
+	"fmt"
+	"html"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/yorkie-team/yorkie/pkg/index"
+)
+
+var (
+	// ErrInvalidProseMirrorNode is returned when the given ProseMirror node
+	// cannot be converted to TreeNode.
+	ErrInvalidProseMirrorNode = errors.New("invalid ProseMirror node")
+)
+
+// ProseMirrorNode is a node of ProseMirror JSON.
+type ProseMirrorNode struct {
+	Type    string            `json:"type"`
+	Attrs   map[string]any    `json:"attrs,omitempty"`
+	Content []ProseMirrorNode `json:"content,omitempty"`
+	Text    string            `json:"text,omitempty"`
+	Marks   []ProseMirrorMark `json:"marks,omitempty"`
+}
+
+// ProseMirrorMark is a mark of the text node of ProseMirror JSON.
+type ProseMirrorMark struct {
+	Type  string         `json:"type"`
+	Attrs map[string]any `json:"attrs,omitempty"`
+}
+
+// htmlVoidElements is the set of HTML elements that cannot have children.
+var htmlVoidElements = map[string]bool{
+	"br": true, "hr": true, "img": true, "input": true,
+}
+
+// ToProseMirror returns the ProseMirror JSON of this tree. Element nodes of
+// the given mark types are converted into the marks of their text nodes,
+// because marks are represented as element nodes wrapping text in the tree.
+func (t *Tree) ToProseMirror(markTypes ...string) ProseMirrorNode {
+	nodes := toProseMirrorNodes(toTreeNode(t.Root()), markTypes, nil)
+	return nodes[0]
+}
+
+// ToHTML returns the HTML of the contents of this tree. The root node is not
+// rendered, and the types of element nodes are used as the tag names.
+func (t *Tree) ToHTML() string {
+	sb := strings.Builder{}
+	for _, child := range toTreeNode(t.Root()).Children {
+		writeHTML(&sb, child)
+	}
+	return sb.String()
+}
+
+// ToMarkdown returns the Markdown of the contents of this tree. The node
+// types of ProseMirror, e.g. "heading" and "bullet_list", and the tag names of
+// HTML, e.g. "h1" and "ul", are rendered as Markdown. Element nodes of other
+// types are rendered as their contents.
+func (t *Tree) ToMarkdown() string {
+	return toMarkdownBlocks(toTreeNode(t.Root()).Children)
+}
+
+// TreeNodeFromProseMirror returns the TreeNode of the given ProseMirror node.
+// The marks of text nodes are converted into element nodes wrapping them, and
+// the attributes that are not strings are stored as their JSON encoding.
+func TreeNodeFromProseMirror(node ProseMirrorNode) (*TreeNode, error) {
+	nodes, err := fromProseMirrorNode(node)
+	if err != nil {
+		return nil, err
+	}
+	if len(nodes) != 1 || nodes[0].Type == index.DefaultTextType {
+		return nil, fmt.Errorf("root should be an element node: %w", ErrInvalidProseMirrorNode)
+	}
+
+	return &nodes[0], nil
+}
+
+// toProseMirrorNodes returns the ProseMirror nodes of the given node. It can
+// return multiple nodes if the given node is a mark.
+func toProseMirrorNodes(node TreeNode, markTypes []string, marks []ProseMirrorMark) []ProseMirrorNode {
+	if node.Type == index.DefaultTextType {
+		return []ProseMirrorNode{{Type: "text", Text: node.Value, Marks: marks}}
+	}
+
+	if slices.Contains(markTypes, node.Type) {
+		childMarks := append(slices.Clone(marks), ProseMirrorMark{
+			Type:  node.Type,
+			Attrs: toProseMirrorAttrs(node.Attributes),
+		})
+
+		var nodes []ProseMirrorNode
+		for _, child := range node.Children {
+			nodes = append(nodes, toProseMirrorNodes(child, markTypes, childMarks)...)
+		}
+		return nodes
+	}
+
+	converted := ProseMirrorNode{
+		Type:  node.Type,
+		Attrs: toProseMirrorAttrs(node.Attributes),
+	}
+	for _, child := range node.Children {
+		converted.Content = append(converted.Content, toProseMirrorNodes(child, markTypes, nil)...)
+	}
+	return []ProseMirrorNode{converted}
+}
+
+// toProseMirrorAttrs converts the given attributes of the tree node to the
+// attrs of ProseMirror.
+func toProseMirrorAttrs(attributes map[string]string) map[string]any {
+	if len(attributes) == 0 {
+		return nil
+	}
+
+	attrs := make(map[string]any, len(attributes))
+	for k, v := range attributes {
+		attrs[k] = v
+	}
+	return attrs
+}
+
+// fromProseMirrorNode returns the tree nodes of the given ProseMirror node.
+func fromProseMirrorNode(node ProseMirrorNode) ([]TreeNode, error) {
+	if node.Type == "" {
+		return nil, fmt.Errorf("node without type: %w", ErrInvalidProseMirrorNode)
+	}
+
+	if node.Type == "text" {
+		if node.Text == "" {
+			return nil, ErrEmptyTextNode
+		}
+
+		converted := TreeNode{Type: index.DefaultTextType, Value: node.Text}
+		for i := len(node.Marks) - 1; i >= 0; i-- {
+			converted = TreeNode{
+				Type:       node.Marks[i].Type,
+				Attributes: fromProseMirrorAttrs(node.Marks[i].Attrs),
+				Children:   []TreeNode{converted},
+			}
+		}
+		return []TreeNode{converted}, nil
+	}
+
+	converted := TreeNode{
+		Type:       node.Type,
+		Attributes: fromProseMirrorAttrs(node.Attrs),
+	}
+	for _, child := range node.Content {
+		children, err := fromProseMirrorNode(child)
+		if err != nil {
+			return nil, err
+		}
+		converted.Children = append(converted.Children, children...)
+	}
+	return []TreeNode{converted}, nil
+}
+
+// fromProseMirrorAttrs converts the given attrs of ProseMirror to the
+// attributes of the tree node. The attrs whose value is nil are omitted.
+func fromProseMirrorAttrs(attrs map[string]any) map[string]string {
+	var attributes map[string]string
+	for k, v := range attrs {
+		if v == nil {
+			continue
+		}
+		if attributes == nil {
+			attributes = make(map[string]string)
+		}
+		attributes[k] = toAttributeValue(v)
+	}
+	return attributes
+}
+
+// writeHTML writes the HTML of the given node to the given builder.
+func writeHTML(sb *strings.Builder, node TreeNode) {
+	if node.Type == index.DefaultTextType {
+		sb.WriteString(html.EscapeString(node.Value))
+		return
+	}
+
+	sb.WriteString("<" + node.Type)
+	keys := make([]string, 0, len(node.Attributes))
+	for k := range node.Attributes {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	for _, k := range keys {
+		sb.WriteString(" " + k + `="` + html.EscapeString(node.Attributes[k]) + `"`)
+	}
+	sb.WriteString(">")
+
+	if htmlVoidElements[node.Type] && len(node.Children) == 0 {
+		return
+	}
+	for _, child := range node.Children {
+		writeHTML(sb, child)
+	}
+	sb.WriteString("</" + node.Type + ">")
+}
+
+// toMarkdownBlocks returns the Markdown of the given block nodes.
+func toMarkdownBlocks(nodes []TreeNode) string {
+	var blocks []string
+	for _, node := range nodes {
+		if block := toMarkdownBlock(node); block != "" {
+			blocks = append(blocks, block)
+		}
+	}
+	return strings.Join(blocks, "\n\n")
+}
+
+// toMarkdownBlock returns the Markdown of the given block node.
+func toMarkdownBlock(node TreeNode) string {
+	switch node.Type {
+	case "heading", "h1", "h2", "h3", "h4", "h5", "h6":
+		level := 1
+		if node.Type != "heading" {
+			level, _ = strconv.Atoi(node.Type[1:])
+		} else if l, err := strconv.Atoi(node.Attributes["level"]); err == nil && l >= 1 && l <= 6 {
+			level = l
+		}
+		return strings.Repeat("#", level) + " " + toMarkdownInlines(node.Children)
+	case "blockquote":
+		return prefixLines(toMarkdownBlocks(node.Children), "> ", "> ")
+	case "bullet_list", "bulletList", "ul":
+		var items []string
+		for _, item := range node.Children {
+			items = append(items, prefixLines(toMarkdownBlocks(item.Children), "- ", "  "))
+		}
+		return strings.Join(items, "\n")
+	case "ordered_list", "orderedList", "ol":
+		var items []string
+		for i, item := range node.Children {
+			marker := strconv.Itoa(i+1) + ". "
+			items = append(items, prefixLines(
+				toMarkdownBlocks(item.Children),
+				marker,
+				strings.Repeat(" ", len(marker)),
+			))
+		}
+		return strings.Join(items, "\n")
+	case "code_block", "codeBlock", "pre":
+		return "```" + node.Attributes["language"] + "\n" + toPlainText(node) + "\n```"
+	case "horizontal_rule", "horizontalRule", "hr":
+		return "---"
+	case index.DefaultTextType:
+		return toMarkdownInline(node)
+	}
+
+	for _, child := range node.Children {
+		if child.Type != index.DefaultTextType && isMarkdownBlock(child) {
+			return toMarkdownBlocks(node.Children)
+		}
+	}
+	return toMarkdownInlines(node.Children)
+}
+
+// toMarkdownInlines returns the Markdown of the given inline nodes.
+func toMarkdownInlines(nodes []TreeNode) string {
+	sb := strings.Builder{}
+	for _, node := range nodes {
+		sb.WriteString(toMarkdownInline(node))
+	}
+	return sb.String()
+}
+
+// toMarkdownInline returns the Markdown of the given inline node.
+func toMarkdownInline(node TreeNode) string {
+	switch node.Type {
+	case index.DefaultTextType:
+		return escapeMarkdown(node.Value)
+	case "strong", "bold", "b":
+		return "**" + toMarkdownInlines(node.Children) + "**"
+	case "em", "italic", "i":
+		return "*" + toMarkdownInlines(node.Children) + "*"
+	case "strike", "s", "del":
+		return "~~" + toMarkdownInlines(node.Children) + "~~"
+	case "code":
+		return "`" + toPlainText(node) + "`"
+	case "link", "a":
+		return "[" + toMarkdownInlines(node.Children) + "](" + node.Attributes["href"] + ")"
+	case "image", "img":
+		return "![" + node.Attributes["alt"] + "](" + node.Attributes["src"] + ")"
+	case "hard_break", "hardBreak", "br":
+		return "  \n"
+	}
+
+	return toMarkdownInlines(node.Children)
+}
+
+// isMarkdownBlock returns whether the given node is rendered as a block of
+// Markdown.
+func isMarkdownBlock(node TreeNode) bool {
+	switch node.Type {
+	case "paragraph", "p", "heading", "h1", "h2", "h3", "h4", "h5", "h6",
+		"blockquote", "bullet_list", "bulletList", "ul", "ordered_list", "orderedList", "ol",
+		"list_item", "listItem", "li", "code_block", "codeBlock", "pre",
+		"horizontal_rule", "horizontalRule", "hr":
+		return true
+	}
+	return false
+}
+
+// toPlainText returns the concatenated values of the text nodes in the
+// given node.
+func toPlainText(node TreeNode) string {
+	if node.Type == index.DefaultTextType {
+		return node.Value
+	}
+
+	sb := strings.Builder{}
+	for _, child := range node.Children {
+		sb.WriteString(toPlainText(child))
+	}
+	return sb.String()
+}
+
+// prefixLines prefixes the first line of the given text with the given first
+// prefix and the other lines with the given rest prefix.
+func prefixLines(text, first, rest string) string {
+	lines := strings.Split(text, "\n")
+	for i, line := range lines {
+		if i == 0 {
+			lines[i] = first + line
+		} else if line != "" {
+			lines[i] = rest + line
+		} else {
+			lines[i] = strings.TrimRight(rest, " ")
+		}
+	}
+	return strings.Join(lines, "\n")
+}
+
+// markdownEscaper escapes the characters that have special meanings in
+// Markdown.
+var markdownEscaper = strings.NewReplacer(
+	`\`, `\\`, "*", `\*`, "_", `\_`, "`", "\\`", "[", `\[`, "]", `\]`,
+)
+
+// escapeMarkdown escapes the given text for Markdown.
+func escapeMarkdown(text string) string {
+	return markdownEscaper.Replace(text)
+}
@@ -0,0 +1,90 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	gosync "sync"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+)
+
+// LocalState is the state of the document kept in the local storage of the
+// client. It is used to resume the synchronization of the document after the
+// client restarts.
+type LocalState struct {
+	// Snapshot is the snapshot of the root and the presences. It includes the
+	// local changes that are not yet sent to the server.
+	Snapshot []byte
+
+	// Checkpoint is the checkpoint of the document.
+	Checkpoint change.Checkpoint
+
+	// ChangeID is the ID of the last change. It has the actor, the lamport
+	// timestamp and the version vector of the document.
+	ChangeID change.ID
+
+	// LocalChanges is the list of the changes that are not yet sent to the
+	// server.
+	LocalChanges []*change.Change
+}
+
+// LocalState returns the local state of this document.
+func (d *Document) LocalState() (*LocalState, error) {
+	d.lock()
+	defer d.unlock()
+
+	snapshot, err := converter.SnapshotToBytes(d.doc.RootObject(), d.doc.AllPresences())
+	if err != nil {
+		return nil, err
+	}
+
+	return &LocalState{
+		Snapshot:     snapshot,
+		Checkpoint:   d.doc.checkpoint,
+		ChangeID:     d.doc.changeID,
+		LocalChanges: append([]*change.Change(nil), d.doc.localChanges...),
+	}, nil
+}
+
+// ApplyLocalState replaces the state of this document with the given local
+// state. The status of the document is not changed.
+func (d *Document) ApplyLocalState(state *LocalState) error {
+	d.lock()
+	defer d.unlock()
+
+	obj, presences, err := converter.BytesToSnapshot(state.Snapshot)
+	if err != nil {
+		return err
+	}
+
+	d.cloneRoot = nil
+	d.clonePresences = nil
+	d.setInternalDoc(&InternalDocument{
+		key:           d.doc.key,
+		status:        d.doc.status,
+		root:          crdt.NewRoot(obj),
+		presences:     presences,
+		onlineClients: &gosync.Map{},
+		checkpoint:    state.Checkpoint,
+		changeID:      state.ChangeID,
+		localChanges:  append([]*change.Change(nil), state.LocalChanges...),
+	})
+
+	return nil
+}
@@ -0,0 +1,460 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"strconv"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// OperationType represents the type of the operation applied to the document.
+type OperationType string
+
+const (
+	// OperationSet means that a member of an object has been set.
+	OperationSet OperationType = "set"
+
+	// OperationAdd means that an element has been added to an array.
+	OperationAdd OperationType = "add"
+
+	// OperationRemove means that an element has been removed from a container.
+	OperationRemove OperationType = "remove"
+
+	// OperationMove means that an element of an array has been moved.
+	OperationMove OperationType = "move"
+
+	// OperationArraySet means that an element of an array has been replaced.
+	OperationArraySet OperationType = "array-set"
+
+	// OperationIncrease means that a counter has been increased.
+	OperationIncrease OperationType = "increase"
+
+	// OperationEdit means that the contents of a text have been edited.
+	OperationEdit OperationType = "edit"
+
+	// OperationStyle means that the attributes of a text have been changed.
+	OperationStyle OperationType = "style"
+
+	// OperationTreeEdit means that the nodes of a tree have been edited.
+	OperationTreeEdit OperationType = "tree-edit"
+
+	// OperationTreeStyle means that the attributes of a tree have been changed.
+	OperationTreeStyle OperationType = "tree-style"
+
+	// OperationTreeMove means that the nodes of a tree have been moved.
+	OperationTreeMove OperationType = "tree-move"
+)
+
+// ChangeInfo represents the information of a change applied to the document.
+type ChangeInfo struct {
+	ActorID    *time.ActorID
+	Message    string
+	ClientSeq  uint32
+	ServerSeq  int64
+	Operations []OperationInfo
+
+	// Patches is the JSON Patch(RFC 6902) of the operations. It is only set
+	// for the remote changes applied by ApplyChangesWithPatches, e.g. while
+	// the document has subscriptions by SubscribePatches.
+	Patches []json.PatchOperation
+}
+
+// OperationInfo represents the information of an operation applied to the
+// document. Path is the path of the target element of the operation, e.g.
+// "$.todos", and only the fields related to the Type are set. The keys that
+// contain special characters in Path are quoted, e.g. `$["a.b"]`, so that
+// crdt.SplitPath splits it back into the keys.
+type OperationInfo struct {
+	Type OperationType
+	Path string
+
+	// Key is the key of the member of an object. It is set for OperationSet
+	// and OperationRemove on an object.
+	Key string
+
+	// Index is the index of the element of an array. It is set for
+	// OperationAdd, OperationMove, OperationArraySet and OperationRemove on
+	// an array. PreviousIndex is the index before OperationMove.
+	Index         int
+	PreviousIndex int
+
+	// Value is the increased amount of OperationIncrease.
+	Value interface{}
+
+	// From and To are the range [From, To) of the text or the tree before the
+	// operation. FromPath and ToPath are the same range of the tree as paths.
+	From     int
+	To       int
+	FromPath []int
+	ToPath   []int
+
+	// Target and TargetPath are the position of the tree where the node is
+	// moved to by OperationTreeMove. They are also before the operation, and
+	// From and To are the range of the moved node.
+	Target     int
+	TargetPath []int
+
+	// Content is the inserted content of OperationEdit, and Contents is the
+	// inserted nodes of OperationTreeEdit.
+	Content    string
+	Contents   []json.TreeNode
+	SplitLevel int
+
+	Attributes         map[string]string
+	AttributesToRemove []string
+}
+
+// ElementPath returns the path of the element written by this operation, e.g.
+// "$.todos.3" for OperationAdd to "$.todos". For OperationMove and the
+// operations on texts, trees and counters, it is the path of the container.
+func (i *OperationInfo) ElementPath() string {
+	return crdt.JoinPath(i.ElementSubPaths())
+}
+
+// ElementSubPaths returns the sub paths of ElementPath, e.g. ["$", "todos",
+// "3"]. It returns nil if Path is not a valid path.
+func (i *OperationInfo) ElementSubPaths() []string {
+	target, err := crdt.SplitPath(i.Path)
+	if err != nil {
+		return nil
+	}
+
+	switch i.Type {
+	case OperationSet:
+		target = append(target, i.Key)
+	case OperationAdd, OperationArraySet:
+		target = append(target, strconv.Itoa(i.Index))
+	case OperationRemove:
+		if i.Key != "" {
+			target = append(target, i.Key)
+		} else {
+			target = append(target, strconv.Itoa(i.Index))
+		}
+	}
+	return target
+}
+
+// touches returns whether this operation touches the element of the given
+// sub paths. The operation touches the element if its target is the element
+// or the descendant of it, or if its target is the ancestor of the element
+// such as replacing the parent of the element.
+func (i *OperationInfo) touches(subPaths []string) bool {
+	target := i.ElementSubPaths()
+
+	n := len(target)
+	if len(subPaths) < n {
+		n = len(subPaths)
+	}
+	for idx := 0; idx < n; idx++ {
+		if target[idx] != subPaths[idx] {
+			return false
+		}
+	}
+	return true
+}
+
+// newChangeInfo creates a new instance of ChangeInfo of the given change.
+func newChangeInfo(c *change.Change, infos []OperationInfo) *ChangeInfo {
+	return &ChangeInfo{
+		ActorID:    c.ID().ActorID(),
+		Message:    c.Message(),
+		ClientSeq:  c.ClientSeq(),
+		ServerSeq:  c.ServerSeq(),
+		Operations: infos,
+	}
+}
+
+// collectOperationInfos returns an interceptor that appends the information
+// of operations executed on the given root to the given infos. If the given
+// patches is not nil, it also appends the JSON Patch of the operations.
+func collectOperationInfos(
+	root *crdt.Root,
+	infos *[]OperationInfo,
+	patches *[]json.PatchOperation,
+) change.Interceptor {
+	return func(op operations.Operation, execute func() error) error {
+		info, err := executeWithInfo(root, op, execute)
+		if err != nil {
+			return err
+		}
+
+		if info != nil {
+			*infos = append(*infos, *info)
+			if patches != nil {
+				if patch := toPatchOperation(root, op, info); patch != nil {
+					*patches = append(*patches, *patch)
+				}
+			}
+		}
+		return nil
+	}
+}
+
+// executeWithInfo executes the given operation and returns the information of
+// it. If the target of the operation cannot be found in the root, it returns
+// nil information.
+func executeWithInfo(
+	root *crdt.Root,
+	op operations.Operation,
+	execute func() error,
+) (*OperationInfo, error) {
+	path, pathErr := root.CreatePath(op.ParentCreatedAt())
+	if pathErr != nil {
+		return nil, execute()
+	}
+	parent := root.FindByCreatedAt(op.ParentCreatedAt())
+
+	switch op := op.(type) {
+	case *operations.Set:
+		if err := execute(); err != nil {
+			return nil, err
+		}
+		return &OperationInfo{Type: OperationSet, Path: path, Key: op.Key()}, nil
+	case *operations.Add:
+		if err := execute(); err != nil {
+			return nil, err
+		}
+		array, ok := parent.(*crdt.Array)
+		if !ok {
+			return nil, nil
+		}
+		idx, err := array.IndexOf(op.Value().CreatedAt())
+		if err != nil {
+			return nil, nil
+		}
+		return &OperationInfo{Type: OperationAdd, Path: path, Index: idx}, nil
+	case *operations.Remove:
+		info := &OperationInfo{Type: OperationRemove, Path: path}
+		switch parent := parent.(type) {
+		case *crdt.Array:
+			idx, err := parent.IndexOf(op.CreatedAt())
+			if err != nil {
+				return nil, execute()
+			}
+			info.Index = idx
+		case *crdt.Object:
+			key, err := parent.SubPathOf(op.CreatedAt())
+			if err != nil {
+				return nil, execute()
+			}
+			info.Key = key
+		default:
+			return nil, execute()
+		}
+
+		// NOTE: The element may have already been removed by the concurrent
+		// operation. In this case, there is nothing to notify.
+		elem := root.FindByCreatedAt(op.CreatedAt())
+		alreadyRemoved := elem != nil && elem.RemovedAt() != nil
+		if err := execute(); err != nil {
+			return nil, err
+		}
+		if alreadyRemoved {
+			return nil, nil
+		}
+		return info, nil
+	case *operations.Move:
+		array, ok := parent.(*crdt.Array)
+		if !ok {
+			return nil, execute()
+		}
+		prevIdx, err := array.IndexOf(op.CreatedAt())
+		if err != nil {
+			return nil, execute()
+		}
+		if err := execute(); err != nil {
+			return nil, err
+		}
+		idx, err := array.IndexOf(op.CreatedAt())
+		if err != nil {
+			return nil, nil
+		}
+		return &OperationInfo{Type: OperationMove, Path: path, PreviousIndex: prevIdx, Index: idx}, nil
+	case *operations.ArraySet:
+		array, ok := parent.(*crdt.Array)
+		if !ok {
+			return nil, execute()
+		}
+		idx, err := array.IndexOf(op.CreatedAt())
+		if err != nil {
+			return nil, execute()
+		}
+		if err := execute(); err != nil {
+			return nil, err
+		}
+		return &OperationInfo{Type: OperationArraySet, Path: path, Index: idx}, nil
+	case *operations.Increase:
+		if err := execute(); err != nil {
+			return nil, err
+		}
+		info := &OperationInfo{Type: OperationIncrease, Path: path}
+		if primitive, ok := op.Value().(*crdt.Primitive); ok {
+			info.Value = primitive.Value()
+		}
+		return info, nil
+	case *operations.Edit:
+		text, ok := parent.(*crdt.Text)
+		if !ok {
+			return nil, execute()
+		}
+		from, to, err := text.FindIndexesFromRange(op.From(), op.To())
+		if err != nil {
+			return nil, execute()
+		}
+		if err := execute(); err != nil {
+			return nil, err
+		}
+		return &OperationInfo{
+			Type:       OperationEdit,
+			Path:       path,
+			From:       from,
+			To:         to,
+			Content:    op.Content(),
+			Attributes: op.Attributes(),
+		}, nil
+	case *operations.Style:
+		text, ok := parent.(*crdt.Text)
+		if !ok {
+			return nil, execute()
+		}
+		from, to, err := text.FindIndexesFromRange(op.From(), op.To())
+		if err != nil {
+			return nil, execute()
+		}
+		if err := execute(); err != nil {
+			return nil, err
+		}
+		return &OperationInfo{
+			Type:               OperationStyle,
+			Path:               path,
+			From:               from,
+			To:                 to,
+			Attributes:         op.Attributes(),
+			AttributesToRemove: op.AttributesToRemove(),
+		}, nil
+	case *operations.TreeEdit:
+		tree, ok := parent.(*crdt.Tree)
+		if !ok {
+			return nil, execute()
+		}
+		info, err := newTreeRangeInfo(tree, op.FromPos(), op.ToPos())
+		if err != nil {
+			return nil, execute()
+		}
+		if err := execute(); err != nil {
+			return nil, err
+		}
+
+		info.Type = OperationTreeEdit
+		info.Path = path
+		info.SplitLevel = op.SplitLevel()
+		for _, content := range op.Contents() {
+			info.Contents = append(info.Contents, toJSONTreeNode(content))
+		}
+		return info, nil
+	case *operations.TreeStyle:
+		tree, ok := parent.(*crdt.Tree)
+		if !ok {
+			return nil, execute()
+		}
+		info, err := newTreeRangeInfo(tree, op.FromPos(), op.ToPos())
+		if err != nil {
+			return nil, execute()
+		}
+		if err := execute(); err != nil {
+			return nil, err
+		}
+
+		info.Type = OperationTreeStyle
+		info.Path = path
+		info.Attributes = op.Attributes()
+		info.AttributesToRemove = op.AttributesToRemove()
+		return info, nil
+	case *operations.TreeMove:
+		tree, ok := parent.(*crdt.Tree)
+		if !ok {
+			return nil, execute()
+		}
+		from, to, err := tree.NodeRange(op.NodeID())
+		if err != nil {
+			return nil, execute()
+		}
+		info, err := newTreeIndexRangeInfo(tree, from, to)
+		if err != nil {
+			return nil, execute()
+		}
+		target, err := tree.PosToIndex(op.TargetPos())
+		if err != nil {
+			return nil, execute()
+		}
+		targetPath, err := tree.IndexToPath(target)
+		if err != nil {
+			return nil, execute()
+		}
+		if err := execute(); err != nil {
+			return nil, err
+		}
+
+		info.Type = OperationTreeMove
+		info.Path = path
+		info.Target = target
+		info.TargetPath = targetPath
+		return info, nil
+	}
+
+	return nil, execute()
+}
+
+// newTreeRangeInfo returns the information that has the range of the given
+// positions of the tree as indexes and paths.
+func newTreeRangeInfo(tree *crdt.Tree, fromPos, toPos *crdt.TreePos) (*OperationInfo, error) {
+	from, err := tree.PosToIndex(fromPos)
+	if err != nil {
+		return nil, err
+	}
+	to, err := tree.PosToIndex(toPos)
+	if err != nil {
+		return nil, err
+	}
+
+	return newTreeIndexRangeInfo(tree, from, to)
+}
+
+// newTreeIndexRangeInfo returns the information that has the given range of
+// the tree as indexes and paths.
+func newTreeIndexRangeInfo(tree *crdt.Tree, from, to int) (*OperationInfo, error) {
+	fromPath, err := tree.IndexToPath(from)
+	if err != nil {
+		return nil, err
+	}
+	toPath, err := tree.IndexToPath(to)
+	if err != nil {
+		return nil, err
+	}
+
+	return &OperationInfo{
+		From:     from,
+		To:       to,
+		FromPath: fromPath,
+		ToPath:   toPath,
+	}, nil
+}
@@ -0,0 +1,322 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+func TestOperationInfo(t *testing.T) {
+	t.Run("object and array operations test", func(t *testing.T) {
+		doc := document.New("d1")
+		var events []document.DocEvent
+		doc.SubscribeChanges(func(e document.DocEvent) {
+			events = append(events, e)
+		})
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			todos := root.SetNewArray("todos")
+			todos.AddString("a", "b", "c")
+			root.SetNewObject("info").SetString("title", "yorkie")
+			root.SetNewCounter("cnt", crdt.IntegerCnt, 0).Increase(3)
+			return nil
+		}, "init"))
+		assert.Len(t, events, 1)
+		assert.Equal(t, document.LocalChangeEvent, events[0].Type)
+		assert.Equal(t, "init", events[0].Change.Message)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationSet, Path: "$", Key: "todos"},
+			{Type: document.OperationAdd, Path: "$.todos", Index: 0},
+			{Type: document.OperationAdd, Path: "$.todos", Index: 1},
+			{Type: document.OperationAdd, Path: "$.todos", Index: 2},
+			{Type: document.OperationSet, Path: "$", Key: "info"},
+			{Type: document.OperationSet, Path: "$.info", Key: "title"},
+			{Type: document.OperationSet, Path: "$", Key: "cnt"},
+			{Type: document.OperationIncrease, Path: "$.cnt", Value: int32(3)},
+		}, events[0].Change.Operations)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			todos := root.GetArray("todos")
+			todos.MoveBefore(todos.Get(0).CreatedAt(), todos.Get(2).CreatedAt())
+			todos.Delete(1)
+			root.GetObject("info").Delete("title")
+			return nil
+		}))
+		assert.Len(t, events, 2)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationMove, Path: "$.todos", PreviousIndex: 2, Index: 0},
+			{Type: document.OperationRemove, Path: "$.todos", Index: 1},
+			{Type: document.OperationRemove, Path: "$.info", Key: "title"},
+		}, events[1].Change.Operations)
+	})
+
+	t.Run("keys with special characters test", func(t *testing.T) {
+		doc := document.New("d1")
+		var events []document.DocEvent
+		_, err := doc.Subscribe(`$["a.b"]`, func(e document.DocEvent) {
+			events = append(events, e)
+		})
+		assert.NoError(t, err)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("a").SetString("b", "x")
+			root.SetNewObject("a.b").SetString("c", "y")
+			return nil
+		}))
+		assert.Len(t, events, 1)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationSet, Path: "$", Key: "a.b"},
+			{Type: document.OperationSet, Path: `$["a.b"]`, Key: "c"},
+		}, events[0].Change.Operations)
+
+		op := events[0].Change.Operations[1]
+		assert.Equal(t, `$["a.b"].c`, op.ElementPath())
+		assert.Equal(t, []string{"$", "a.b", "c"}, op.ElementSubPaths())
+	})
+
+	t.Run("text and tree operations test", func(t *testing.T) {
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewText("text").Edit(0, 0, "hello")
+			root.SetNewTree("tree", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:     "p",
+					Children: []json.TreeNode{{Type: "text", Value: "ab"}},
+				}},
+			})
+			return nil
+		}))
+
+		var events []document.DocEvent
+		unsubscribe := doc.SubscribeChanges(func(e document.DocEvent) {
+			events = append(events, e)
+		})
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(1, 3, "E", map[string]string{"b": "1"})
+			root.GetText("text").Style(0, 1, map[string]string{"i": "1"})
+			root.GetTree("tree").Edit(2, 3, &json.TreeNode{Type: "text", Value: "X"}, 0)
+			root.GetTree("tree").Style(0, 1, map[string]string{"bold": "true"})
+			return nil
+		}))
+		assert.Len(t, events, 1)
+		assert.Equal(t, []document.OperationInfo{
+			{
+				Type:       document.OperationEdit,
+				Path:       "$.text",
+				From:       1,
+				To:         3,
+				Content:    "E",
+				Attributes: map[string]string{"b": "1"},
+			},
+			{
+				Type:               document.OperationStyle,
+				Path:               "$.text",
+				From:               0,
+				To:                 1,
+				Attributes:         map[string]string{"i": "1"},
+				AttributesToRemove: []string{},
+			},
+			{
+				Type:     document.OperationTreeEdit,
+				Path:     "$.tree",
+				From:     2,
+				To:       3,
+				FromPath: []int{0, 1},
+				ToPath:   []int{0, 2},
+				Contents: []json.TreeNode{{Type: "text", Value: "X"}},
+			},
+			{
+				Type:               document.OperationTreeStyle,
+				Path:               "$.tree",
+				From:               0,
+				To:                 1,
+				FromPath:           []int{0},
+				ToPath:             []int{0, 0},
+				Attributes:         map[string]string{"bold": "true"},
+				AttributesToRemove: []string{},
+			},
+		}, events[0].Change.Operations)
+
+		unsubscribe()
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("text").Edit(0, 0, "A")
+			return nil
+		}))
+		assert.Len(t, events, 1)
+	})
+
+	t.Run("remote change event test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1")
+		docA.SetActor(actorA)
+		docB := document.New("d1")
+		docB.SetActor(actorB)
+
+		var events []document.DocEvent
+		docB.SubscribeChanges(func(e document.DocEvent) {
+			events = append(events, e)
+		})
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos").AddString("a", "b", "c", "d")
+			return nil
+		}))
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("todos").Delete(3)
+			return nil
+		}))
+		packA := docA.CreateChangePack()
+		packA.VersionVector.Set(docB.ActorID(), docB.VersionVector().VersionOf(docB.ActorID()))
+		assert.NoError(t, docB.ApplyChangePack(packA))
+
+		assert.Len(t, events, 2)
+		assert.Equal(t, document.RemoteChangeEvent, events[0].Type)
+		assert.Equal(t, actorA, events[0].Change.ActorID)
+		assert.Len(t, events[0].Change.Operations, 5)
+		assert.Equal(t, document.RemoteChangeEvent, events[1].Type)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationRemove, Path: "$.todos", Index: 3},
+		}, events[1].Change.Operations)
+	})
+
+	t.Run("change events on events channel test", func(t *testing.T) {
+		actorA, err := time.ActorIDFromHex("000000000000000000000001")
+		assert.NoError(t, err)
+		actorB, err := time.ActorIDFromHex("000000000000000000000002")
+		assert.NoError(t, err)
+
+		docA := document.New("d1")
+		docA.SetActor(actorA)
+		docB := document.New("d1", document.WithChangeEvents())
+		docB.SetActor(actorB)
+
+		assert.NoError(t, docB.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("b", "x")
+			return nil
+		}))
+		e := <-docB.Events()
+		assert.Equal(t, document.LocalChangeEvent, e.Type)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationSet, Path: "$", Key: "b"},
+		}, e.Change.Operations)
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("a", "x")
+			return nil
+		}))
+		packA := docA.CreateChangePack()
+		packA.VersionVector.Set(docB.ActorID(), docB.VersionVector().VersionOf(docB.ActorID()))
+		assert.NoError(t, docB.ApplyChangePack(packA))
+		e = <-docB.Events()
+		assert.Equal(t, document.RemoteChangeEvent, e.Type)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationSet, Path: "$", Key: "a"},
+		}, e.Change.Operations)
+
+		// the document without the option does not send change events
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("a", "y")
+			return nil
+		}))
+		assert.Len(t, docA.Events(), 0)
+	})
+
+	t.Run("remote change patches test", func(t *testing.T) {
+		docA := document.New("d1")
+		docB := document.New("d1")
+		var patches []json.PatchOperation
+		docB.SubscribePatches(func(e document.DocEvent) {
+			patches = append(patches, e.Change.Patches...)
+		})
+
+		// NOTE: The patches are not set for the local changes.
+		docA.SubscribeChanges(func(e document.DocEvent) {
+			assert.Nil(t, e.Change.Patches)
+		})
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos").AddString("a", "b", "c")
+			root.SetNewObject("a/b").SetInteger("c~d", 1)
+			root.SetNewCounter("cnt", crdt.IntegerCnt, 0).Increase(3)
+			root.SetNewText("text").Edit(0, 0, "hi")
+			return nil
+		}))
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			todos := root.GetArray("todos")
+			todos.MoveBefore(todos.Get(0).CreatedAt(), todos.Get(2).CreatedAt())
+			todos.Delete(1)
+			todos.Set(0, "C")
+			root.Delete("a/b")
+			return nil
+		}))
+
+		packA := docA.CreateChangePack()
+		packA.VersionVector.Set(docB.ActorID(), docB.VersionVector().VersionOf(docB.ActorID()))
+		assert.NoError(t, docB.ApplyChangePack(packA))
+
+		assert.Equal(t, []json.PatchOperation{
+			{Op: json.PatchAdd, Path: "/todos", Value: []byte(`[]`)},
+			{Op: json.PatchAdd, Path: "/todos/0", Value: []byte(`"a"`)},
+			{Op: json.PatchAdd, Path: "/todos/1", Value: []byte(`"b"`)},
+			{Op: json.PatchAdd, Path: "/todos/2", Value: []byte(`"c"`)},
+			{Op: json.PatchAdd, Path: "/a~1b", Value: []byte(`{}`)},
+			{Op: json.PatchAdd, Path: "/a~1b/c~0d", Value: []byte(`1`)},
+			{Op: json.PatchAdd, Path: "/cnt", Value: []byte(`0`)},
+			{Op: json.PatchReplace, Path: "/cnt", Value: []byte(`3`)},
+			{Op: json.PatchAdd, Path: "/text", Value: []byte(`[]`)},
+			{Op: json.PatchReplace, Path: "/text", Value: []byte(`[{"val":"hi"}]`)},
+			{Op: json.PatchMove, From: "/todos/2", Path: "/todos/0"},
+			{Op: json.PatchRemove, Path: "/todos/1"},
+			{Op: json.PatchReplace, Path: "/todos/0", Value: []byte(`"C"`)},
+			{Op: json.PatchRemove, Path: "/a~1b"},
+		}, patches)
+	})
+
+	t.Run("no patches without patch subscriptions test", func(t *testing.T) {
+		docA := document.New("d1")
+		docB := document.New("d1")
+		var events []document.DocEvent
+		docB.SubscribeChanges(func(e document.DocEvent) {
+			events = append(events, e)
+		})
+
+		assert.NoError(t, docA.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		packA := docA.CreateChangePack()
+		packA.VersionVector.Set(docB.ActorID(), docB.VersionVector().VersionOf(docB.ActorID()))
+		assert.NoError(t, docB.ApplyChangePack(packA))
+
+		assert.Len(t, events, 1)
+		assert.Equal(t, document.RemoteChangeEvent, events[0].Type)
+		assert.Nil(t, events[0].Change.Patches)
+	})
+}
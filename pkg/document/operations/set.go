@@ -66,7 +66,7 @@ func (o *Set) Execute(root *crdt.Root, _ time.VersionVector) error {
 		return err
 	}
 	removed := obj.Set(o.key, value)
-	root.RegisterElement(value)
+	root.RegisterElement(obj, value)
 	if removed != nil {
 		root.RegisterRemovedElementPair(obj, removed)
 	}
@@ -36,9 +36,12 @@ type Style struct {
 	// by actor for the nodes included in the range to apply the style to.
 	maxCreatedAtMapByActor map[string]*time.Ticket
 
-	// attributes represents the text style.
+	// attributes represents the text style to be added.
 	attributes map[string]string
 
+	// attributesToRemove represents the text style to be removed.
+	attributesToRemove []string
+
 	// executedAt is the time the operation was executed.
 	executedAt *time.Ticket
 }
@@ -58,6 +61,28 @@ func NewStyle(
 		to:                     to,
 		maxCreatedAtMapByActor: maxCreatedAtMapByActor,
 		attributes:             attributes,
+		attributesToRemove:     []string{},
+		executedAt:             executedAt,
+	}
+}
+
+// NewStyleRemove creates a new instance of Style that removes the given
+// attributes.
+func NewStyleRemove(
+	parentCreatedAt *time.Ticket,
+	from *crdt.RGATreeSplitNodePos,
+	to *crdt.RGATreeSplitNodePos,
+	maxCreatedAtMapByActor map[string]*time.Ticket,
+	attributesToRemove []string,
+	executedAt *time.Ticket,
+) *Style {
+	return &Style{
+		parentCreatedAt:        parentCreatedAt,
+		from:                   from,
+		to:                     to,
+		maxCreatedAtMapByActor: maxCreatedAtMapByActor,
+		attributes:             map[string]string{},
+		attributesToRemove:     attributesToRemove,
 		executedAt:             executedAt,
 	}
 }
@@ -70,7 +95,14 @@ func (e *Style) Execute(root *crdt.Root, versionVector time.VersionVector) error
 		return ErrNotApplicableDataType
 	}
 
-	_, pairs, err := obj.Style(e.from, e.to, e.maxCreatedAtMapByActor, e.attributes, e.executedAt, versionVector)
+	var pairs []crdt.GCPair
+	var err error
+	if len(e.attributesToRemove) > 0 {
+		_, pairs, err = obj.RemoveStyle(e.from, e.to, e.maxCreatedAtMapByActor, e.attributesToRemove,
+			e.executedAt, versionVector)
+	} else {
+		_, pairs, err = obj.Style(e.from, e.to, e.maxCreatedAtMapByActor, e.attributes, e.executedAt, versionVector)
+	}
 	if err != nil {
 		return err
 	}
@@ -112,6 +144,11 @@ func (e *Style) Attributes() map[string]string {
 	return e.attributes
 }
 
+// AttributesToRemove returns the attributes to be removed by this operation.
+func (e *Style) AttributesToRemove() []string {
+	return e.attributesToRemove
+}
+
 // MaxCreatedAtMapByActor returns the map that stores the latest creation time
 // by actor for the nodes included in the range to apply the style to.
 func (e *Style) MaxCreatedAtMapByActor() map[string]*time.Ticket {
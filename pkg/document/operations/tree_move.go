@@ -0,0 +1,99 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package operations
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// TreeMove is an operation representing moving a node of Tree.
+type TreeMove struct {
+	// parentCreatedAt is the creation time of the Tree that executes
+	// TreeMove.
+	parentCreatedAt *time.Ticket
+
+	// nodeID is the ID of the node to be moved.
+	nodeID *crdt.TreeNodeID
+
+	// target represents the position where the node is moved to.
+	target *crdt.TreePos
+
+	// executedAt is the time the operation was executed.
+	executedAt *time.Ticket
+}
+
+// NewTreeMove creates a new instance of TreeMove.
+func NewTreeMove(
+	parentCreatedAt *time.Ticket,
+	nodeID *crdt.TreeNodeID,
+	target *crdt.TreePos,
+	executedAt *time.Ticket,
+) *TreeMove {
+	return &TreeMove{
+		parentCreatedAt: parentCreatedAt,
+		nodeID:          nodeID,
+		target:          target,
+		executedAt:      executedAt,
+	}
+}
+
+// Execute executes this operation on the given `CRDTRoot`.
+func (m *TreeMove) Execute(root *crdt.Root, versionVector time.VersionVector) error {
+	parent := root.FindByCreatedAt(m.parentCreatedAt)
+
+	switch obj := parent.(type) {
+	case *crdt.Tree:
+		pairs, err := obj.Move(m.nodeID, m.target, m.executedAt, versionVector)
+		if err != nil {
+			return err
+		}
+
+		for _, pair := range pairs {
+			root.RegisterGCPair(pair)
+		}
+	default:
+		return ErrNotApplicableDataType
+	}
+
+	return nil
+}
+
+// NodeID returns the ID of the node to be moved.
+func (m *TreeMove) NodeID() *crdt.TreeNodeID {
+	return m.nodeID
+}
+
+// TargetPos returns the position where the node is moved to.
+func (m *TreeMove) TargetPos() *crdt.TreePos {
+	return m.target
+}
+
+// SetActor sets the given actor to this operation.
+func (m *TreeMove) SetActor(actorID *time.ActorID) {
+	m.executedAt = m.executedAt.SetActorID(actorID)
+}
+
+// ParentCreatedAt returns the creation time of the Tree.
+func (m *TreeMove) ParentCreatedAt() *time.Ticket {
+	return m.parentCreatedAt
+}
+
+// ExecutedAt returns execution time of this operation.
+func (m *TreeMove) ExecutedAt() *time.Ticket {
+	return m.executedAt
+}
@@ -0,0 +1,97 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	gojson "encoding/json"
+	"strconv"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+)
+
+// toPatchOperation returns the JSON Patch of the given operation executed on
+// the given root with the given information. Operations on texts, trees and
+// counters are translated into replacing the whole element, because JSON
+// Patch cannot express the partial changes of them. It returns nil if the
+// element of the patch cannot be found in the root.
+func toPatchOperation(root *crdt.Root, op operations.Operation, info *OperationInfo) *json.PatchOperation {
+	subPaths, err := root.CreateSubPaths(op.ParentCreatedAt())
+	if err != nil {
+		return nil
+	}
+	pointerOf := func(subPath string) string {
+		return json.ToPointer(append(subPaths, subPath)...)
+	}
+	parent := root.FindByCreatedAt(op.ParentCreatedAt())
+
+	switch info.Type {
+	case OperationSet:
+		obj, ok := parent.(*crdt.Object)
+		if !ok {
+			return nil
+		}
+		return newValuePatch(json.PatchAdd, pointerOf(info.Key), obj.Get(info.Key))
+	case OperationAdd, OperationArraySet:
+		array, ok := parent.(*crdt.Array)
+		if !ok {
+			return nil
+		}
+		elem, err := array.Get(info.Index)
+		if err != nil {
+			return nil
+		}
+
+		patchType := json.PatchAdd
+		if info.Type == OperationArraySet {
+			patchType = json.PatchReplace
+		}
+		return newValuePatch(patchType, pointerOf(strconv.Itoa(info.Index)), elem)
+	case OperationRemove:
+		subPath := info.Key
+		if _, ok := parent.(*crdt.Array); ok {
+			subPath = strconv.Itoa(info.Index)
+		}
+		return &json.PatchOperation{Op: json.PatchRemove, Path: pointerOf(subPath)}
+	case OperationMove:
+		return &json.PatchOperation{
+			Op:   json.PatchMove,
+			From: pointerOf(strconv.Itoa(info.PreviousIndex)),
+			Path: pointerOf(strconv.Itoa(info.Index)),
+		}
+	case OperationIncrease, OperationEdit, OperationStyle,
+		OperationTreeEdit, OperationTreeStyle, OperationTreeMove:
+		return newValuePatch(json.PatchReplace, json.ToPointer(subPaths...), parent)
+	}
+
+	return nil
+}
+
+// newValuePatch returns a new JSON Patch of the given type with the value of
+// the given element.
+func newValuePatch(patchType, path string, elem crdt.Element) *json.PatchOperation {
+	if elem == nil {
+		return nil
+	}
+
+	return &json.PatchOperation{
+		Op:    patchType,
+		Path:  path,
+		Value: gojson.RawMessage(elem.Marshal()),
+	}
+}
@@ -0,0 +1,319 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"reflect"
+	"sort"
+
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
+	"github.com/yorkie-team/yorkie/pkg/document/time"
+)
+
+// Restore updates this document so that its root becomes the same as the
+// given root, e.g. the root of a past revision of this document. The updates
+// are made as a local change, so other replicas converge to the given root
+// when they apply the change. The elements shared by both roots are updated in
+// place, and the others are rebuilt with new tickets.
+func (d *Document) Restore(root *crdt.Root, msgAndArgs ...interface{}) error {
+	d.lock()
+	defer d.unlock()
+
+	if d.doc.status == StatusRemoved {
+		return ErrDocumentRemoved
+	}
+
+	if err := d.ensureClone(); err != nil {
+		return err
+	}
+
+	ctx := change.NewContext(
+		d.doc.changeID.Next(),
+		messageFromMsgAndArgs(msgAndArgs...),
+		d.cloneRoot,
+	)
+	if err := restoreObject(ctx, d.cloneRoot, d.cloneRoot.Object(), root.Object()); err != nil {
+		// drop cloneRoot because it is contaminated.
+		d.cloneRoot = nil
+		d.clonePresences = nil
+		return err
+	}
+
+	if ctx.HasChange() {
+		reverse, err := d.commit(ctx)
+		if err != nil {
+			return err
+		}
+		d.history.pushUndo(reverse)
+	}
+
+	return nil
+}
+
+// restoreObject updates the given object to be the same as the target object.
+func restoreObject(ctx *change.Context, root *crdt.Root, obj, target *crdt.Object) error {
+	members := target.Members()
+	keys := make([]string, 0, len(members))
+	for k := range members {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		restored, err := restoreInPlace(ctx, root, obj.Get(k), members[k])
+		if err != nil {
+			return err
+		}
+		if restored {
+			continue
+		}
+
+		ticket := ctx.IssueTimeTicket()
+		if err := setValue(ctx, root, members[k], ticket, func(value crdt.Element) operations.Operation {
+			return operations.NewSet(obj.CreatedAt(), k, value, ticket)
+		}); err != nil {
+			return err
+		}
+	}
+
+	current := obj.Members()
+	keys = keys[:0]
+	for k := range current {
+		if !target.Has(k) {
+			keys = append(keys, k)
+		}
+	}
+	sort.Strings(keys)
+
+	for _, k := range keys {
+		op := operations.NewRemove(obj.CreatedAt(), current[k].CreatedAt(), ctx.IssueTimeTicket())
+		if err := pushOperation(ctx, root, op); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
+// restoreArray updates the given array to be the same as the target array.
+// The elements of the target are matched with the elements of the array by
+// their creation times first and then by their contents, so the matched
+// elements are moved instead of being rebuilt.
+func restoreArray(ctx *change.Context, root *crdt.Root, array, target *crdt.Array) error {
+	elems := target.Elements()
+	matched := make(map[string]crdt.Element, len(elems))
+	used := make(map[string]bool)
+	for _, elem := range elems {
+		if isAlive(root, elem.CreatedAt()) {
+			matched[elem.CreatedAt().Key()] = root.FindByCreatedAt(elem.CreatedAt())
+			used[elem.CreatedAt().Key()] = true
+		}
+	}
+
+	current := array.Elements()
+	next := 0
+	for _, elem := range elems {
+		if _, ok := matched[elem.CreatedAt().Key()]; ok {
+			continue
+		}
+		for idx := next; idx < len(current); idx++ {
+			if used[current[idx].CreatedAt().Key()] || !equalElements(current[idx], elem) {
+				continue
+			}
+			matched[elem.CreatedAt().Key()] = current[idx]
+			used[current[idx].CreatedAt().Key()] = true
+			next = idx + 1
+			break
+		}
+	}
+
+	for _, elem := range current {
+		if used[elem.CreatedAt().Key()] {
+			continue
+		}
+		op := operations.NewRemove(array.CreatedAt(), elem.CreatedAt(), ctx.IssueTimeTicket())
+		if err := pushOperation(ctx, root, op); err != nil {
+			return err
+		}
+	}
+
+	prevCreatedAt := time.InitialTicket
+	for _, elem := range elems {
+		match, ok := matched[elem.CreatedAt().Key()]
+		if !ok {
+			ticket := ctx.IssueTimeTicket()
+			if err := setValue(ctx, root, elem, ticket, func(value crdt.Element) operations.Operation {
+				return operations.NewAdd(array.CreatedAt(), prevCreatedAt, value, ticket)
+			}); err != nil {
+				return err
+			}
+			prevCreatedAt = ticket
+			continue
+		}
+
+		actualPrev, err := array.FindPrevCreatedAt(match.CreatedAt())
+		if err != nil {
+			return err
+		}
+		if actualPrev.Compare(prevCreatedAt) != 0 {
+			op := operations.NewMove(array.CreatedAt(), prevCreatedAt, match.CreatedAt(), ctx.IssueTimeTicket())
+			if err := pushOperation(ctx, root, op); err != nil {
+				return err
+			}
+		}
+
+		restored, err := restoreInPlace(ctx, root, match, elem)
+		if err != nil {
+			return err
+		}
+		if !restored {
+			// NOTE: The new element must have the same `createdAt` as the old
+			// element.
+			ticket := ctx.IssueTimeTicket()
+			if err := setValue(ctx, root, elem, match.CreatedAt(), func(value crdt.Element) operations.Operation {
+				return operations.NewArraySet(array.CreatedAt(), match.CreatedAt(), value, ticket)
+			}); err != nil {
+				return err
+			}
+		}
+		prevCreatedAt = match.CreatedAt()
+	}
+
+	return nil
+}
+
+// restoreInPlace updates the given element to be the same as the target
+// element if they are the same element, and returns whether the element has
+// been restored. If it returns false, the element should be replaced.
+func restoreInPlace(ctx *change.Context, root *crdt.Root, elem, target crdt.Element) (bool, error) {
+	if elem == nil {
+		return false, nil
+	}
+	if elem.CreatedAt().Compare(target.CreatedAt()) != 0 {
+		// NOTE: The elements rebuilt by the previous restoration have
+		// different creation times from the target, but they need not be
+		// replaced again if they have the same contents.
+		return equalElements(elem, target), nil
+	}
+
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		target, ok := target.(*crdt.Object)
+		if !ok {
+			return false, nil
+		}
+		return true, restoreObject(ctx, root, elem, target)
+	case *crdt.Array:
+		target, ok := target.(*crdt.Array)
+		if !ok {
+			return false, nil
+		}
+		return true, restoreArray(ctx, root, elem, target)
+	case *crdt.Counter:
+		target, ok := target.(*crdt.Counter)
+		if !ok {
+			return false, nil
+		}
+		return true, restoreCounter(ctx, root, elem, target)
+	}
+
+	return elem.Marshal() == target.Marshal(), nil
+}
+
+// restoreCounter increases the given counter by the difference from the
+// target counter.
+func restoreCounter(ctx *change.Context, root *crdt.Root, counter, target *crdt.Counter) error {
+	var delta interface{}
+	switch value := target.Value().(type) {
+	case int32:
+		if value == counter.Value().(int32) {
+			return nil
+		}
+		delta = value - counter.Value().(int32)
+	case int64:
+		if value == counter.Value().(int64) {
+			return nil
+		}
+		delta = value - counter.Value().(int64)
+	default:
+		return nil
+	}
+
+	ticket := ctx.IssueTimeTicket()
+	value, err := crdt.NewPrimitive(delta, ticket)
+	if err != nil {
+		return err
+	}
+	return pushOperation(ctx, root, operations.NewIncrease(counter.CreatedAt(), value, ticket))
+}
+
+// equalElements returns whether the given elements have the same type and
+// contents.
+func equalElements(a, b crdt.Element) bool {
+	return reflect.TypeOf(a) == reflect.TypeOf(b) && a.Marshal() == b.Marshal()
+}
+
+// setValue rebuilds the given element with the given ticket and pushes the
+// operation that sets the rebuilt value. The contents of a text are not
+// encoded in the operation, so they are edited after the text is set.
+func setValue(
+	ctx *change.Context,
+	root *crdt.Root,
+	elem crdt.Element,
+	ticket *time.Ticket,
+	newOperation func(value crdt.Element) operations.Operation,
+) error {
+	text, ok := elem.(*crdt.Text)
+	if !ok {
+		value, err := rebuildElement(ctx, elem, ticket, make(map[string]*time.Ticket))
+		if err != nil {
+			return err
+		}
+		return pushOperation(ctx, root, newOperation(value))
+	}
+
+	value := crdt.NewText(crdt.NewRGATreeSplit(crdt.InitialTextNode()), ticket)
+	if err := pushOperation(ctx, root, newOperation(value)); err != nil {
+		return err
+	}
+
+	restored, ok := root.FindByCreatedAt(ticket).(*crdt.Text)
+	if !ok {
+		return nil
+	}
+	proxy := json.NewText().Initialize(ctx, restored)
+	for _, node := range text.Nodes() {
+		if node.RemovedAt() != nil {
+			continue
+		}
+		proxy.Edit(proxy.Len(), proxy.Len(), node.Value().Value(), node.Value().Attrs().Elements())
+	}
+	return nil
+}
+
+// pushOperation executes the given operation on the given root and pushes it
+// to the given context.
+func pushOperation(ctx *change.Context, root *crdt.Root, op operations.Operation) error {
+	if err := op.Execute(root, nil); err != nil {
+		return err
+	}
+	ctx.Push(op)
+	return nil
+}
@@ -60,6 +60,14 @@ type Ticket struct {
 	cachedKey string
 }
 
+// TicketStruct is a structure that represents Ticket in a serializable form.
+// Lamport is a string because it can exceed the safe integer range of JSON.
+type TicketStruct struct {
+	Lamport   string `json:"lamport"`
+	Delimiter uint32 `json:"delimiter"`
+	ActorID   string `json:"actorID"`
+}
+
 // NewTicket creates an instance of Ticket.
 func NewTicket(
 	lamport int64,
@@ -73,6 +81,30 @@ func NewTicket(
 	}
 }
 
+// TicketFromStruct creates an instance of Ticket from the given structure.
+func TicketFromStruct(s TicketStruct) (*Ticket, error) {
+	lamport, err := strconv.ParseInt(s.Lamport, 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("parse lamport %s: %w", s.Lamport, err)
+	}
+
+	actorID, err := ActorIDFromHex(s.ActorID)
+	if err != nil {
+		return nil, err
+	}
+
+	return NewTicket(lamport, s.Delimiter, actorID), nil
+}
+
+// ToStruct returns the structure of this ticket in a serializable form.
+func (t *Ticket) ToStruct() TicketStruct {
+	return TicketStruct{
+		Lamport:   strconv.FormatInt(t.lamport, 10),
+		Delimiter: t.delimiter,
+		ActorID:   t.actorID.String(),
+	}
+}
+
 // ToTestString returns a string containing the metadata of the ticket
 // for debugging purpose.
 func (t *Ticket) ToTestString() string {
@@ -55,4 +55,23 @@ func TestTicket(t *testing.T) {
 
 		assert.False(t, before.After(before))
 	})
+
+	t.Run("ticket struct test", func(t *testing.T) {
+		actorID, _ := time.ActorIDFromHex("0123456789abcdef01234567")
+		ticket := time.NewTicket(time.MaxLamport, 1, actorID)
+		assert.Equal(t, time.TicketStruct{
+			Lamport:   "9223372036854775807",
+			Delimiter: 1,
+			ActorID:   "0123456789abcdef01234567",
+		}, ticket.ToStruct())
+
+		clone, err := time.TicketFromStruct(ticket.ToStruct())
+		assert.NoError(t, err)
+		assert.Equal(t, 0, ticket.Compare(clone))
+
+		_, err = time.TicketFromStruct(time.TicketStruct{Lamport: "a", ActorID: actorID.String()})
+		assert.Error(t, err)
+		_, err = time.TicketFromStruct(time.TicketStruct{Lamport: "1", ActorID: "invalid"})
+		assert.ErrorIs(t, err, time.ErrInvalidHexString)
+	})
 }
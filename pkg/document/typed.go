@@ -0,0 +1,68 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package document
+
+import (
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+)
+
+// Typed is a wrapper of the document that binds the root to the struct of
+// type T. The members of the root are matched with the fields of T by the
+// "yorkie" tags.
+type Typed[T any] struct {
+	doc *Document
+}
+
+// NewTyped creates a new instance of Typed of the given document.
+func NewTyped[T any](doc *Document) *Typed[T] {
+	return &Typed[T]{doc: doc}
+}
+
+// Document returns the wrapped document.
+func (t *Typed[T]) Document() *Document {
+	return t.doc
+}
+
+// Get returns the root decoded into T. It returns an error if the root cannot
+// be decoded into T.
+func (t *Typed[T]) Get() (T, error) {
+	t.doc.lock()
+	defer t.doc.unlock()
+
+	var v T
+	if err := json.NewObject(nil, t.doc.doc.RootObject()).Decode(&v); err != nil {
+		return v, err
+	}
+	return v, nil
+}
+
+// Update calls the given updater with the root decoded into T, and updates
+// the document to the modified value with minimal operations. Only the
+// members whose values are changed are set or removed.
+func (t *Typed[T]) Update(updater func(v *T), msgAndArgs ...interface{}) error {
+	return t.doc.Update(func(root *json.Object, p *presence.Presence) error {
+		var v T
+		if err := root.Decode(&v); err != nil {
+			return err
+		}
+
+		updater(&v)
+		root.Assign(&v)
+		return nil
+	}, msgAndArgs...)
+}
@@ -557,6 +557,48 @@ func (n *Node[V]) RemoveChild(child *Node[V]) error {
 	return nil
 }
 
+// Swap exchanges the positions of the given two nodes in the tree and updates
+// the size of their ancestors. The nodes should not be ancestors of each other.
+func Swap[V Value](a, b *Node[V]) error {
+	aParent, bParent := a.Parent, b.Parent
+	if aParent == nil || bParent == nil {
+		return ErrChildNotFound
+	}
+
+	aOffset := aParent.OffsetOfChild(a)
+	bOffset := bParent.OffsetOfChild(b)
+	if aOffset == -1 || bOffset == -1 {
+		return ErrChildNotFound
+	}
+
+	a.addAncestorsLength(-a.PaddedLength())
+	b.addAncestorsLength(-b.PaddedLength())
+
+	aParent.children[aOffset] = b
+	bParent.children[bOffset] = a
+	a.Parent, b.Parent = bParent, aParent
+
+	a.addAncestorsLength(a.PaddedLength())
+	b.addAncestorsLength(b.PaddedLength())
+
+	return nil
+}
+
+// addAncestorsLength adds the given delta to the length of ancestors if the
+// node is not removed.
+func (n *Node[V]) addAncestorsLength(delta int) {
+	if n.Value.IsRemoved() {
+		return
+	}
+
+	for parent := n.Parent; parent != nil; parent = parent.Parent {
+		parent.Length += delta
+		if parent.Value.IsRemoved() {
+			break
+		}
+	}
+}
+
 // InsertBefore inserts the given node before the given child.
 func (n *Node[V]) InsertBefore(newNode, referenceNode *Node[V]) error {
 	if n.IsText() {
@@ -725,7 +767,15 @@ func (t *Tree[V]) TreePosToPath(treePos *TreePos[V]) ([]int, error) {
 	node := treePos.Node
 
 	if node.IsText() {
-		offset := node.Parent.OffsetOfChild(node)
+		// NOTE: The offset is counted without the tombstones because
+		// LeftSiblingsSize only counts the size of the live children.
+		offset := -1
+		for i, child := range node.Parent.Children() {
+			if child == node {
+				offset = i
+				break
+			}
+		}
 		if offset == -1 {
 			return nil, ErrInvalidTreePos
 		}
@@ -21,7 +21,9 @@ import (
 
 	"github.com/stretchr/testify/assert"
 
+	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -443,4 +445,33 @@ func TestIndexTree(t *testing.T) {
 		assert.NoError(t, pathErr)
 		assert.Equal(t, []int{1}, path)
 	})
+
+	t.Run("find path from given treePos next to tombstone test", func(t *testing.T) {
+		doc := document.New("test")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			tree := root.SetNewTree("t", &json.TreeNode{
+				Type:     "r",
+				Children: []json.TreeNode{{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "ab"}}}},
+			})
+			tree.Edit(3, 3, &json.TreeNode{Type: "text", Value: "cd"}, 0)
+			tree.Edit(1, 3, nil, 0)
+			return nil
+		}))
+		assert.Equal(t, "<r><p>cd</p></r>", doc.Root().GetTree("t").ToXML())
+
+		//    0   1 2 3    4
+		// <r> <p> c d </p> </r>
+		tree := doc.Root().GetTree("t").IndexTree
+		pos, posErr := tree.FindTreePos(2)
+		assert.NoError(t, posErr)
+		path, pathErr := tree.TreePosToPath(pos)
+		assert.NoError(t, pathErr)
+		assert.Equal(t, []int{0, 1}, path)
+
+		pos, posErr = tree.FindTreePos(3)
+		assert.NoError(t, posErr)
+		path, pathErr = tree.TreePosToPath(pos)
+		assert.NoError(t, pathErr)
+		assert.Equal(t, []int{0, 2}, path)
+	})
 }
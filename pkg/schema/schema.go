@@ -0,0 +1,235 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+// Package schema provides the rule set that describes the shape of the root of
+// documents, and validates the root with it.
+package schema
+
+import (
+	"bytes"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"slices"
+
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+)
+
+var (
+	// ErrInvalidSchema is returned when the given rule set is malformed.
+	ErrInvalidSchema = errors.New("invalid schema")
+
+	// ErrSchemaViolation is returned when the root of the document violates
+	// the rule set.
+	ErrSchemaViolation = errors.New("schema violation")
+)
+
+// The types of the elements that can be used in the rule.
+const (
+	TypeObject  = "object"
+	TypeArray   = "array"
+	TypeString  = "string"
+	TypeInteger = "integer"
+	TypeNumber  = "number"
+	TypeBoolean = "boolean"
+	TypeNull    = "null"
+	TypeBytes   = "bytes"
+	TypeDate    = "date"
+	TypeText    = "text"
+	TypeTree    = "tree"
+	TypeCounter = "counter"
+)
+
+var types = []string{
+	TypeObject, TypeArray, TypeString, TypeInteger, TypeNumber, TypeBoolean,
+	TypeNull, TypeBytes, TypeDate, TypeText, TypeTree, TypeCounter,
+}
+
+// Rule is a rule of an element. It is a subset of JSON Schema extended with
+// the types of Yorkie such as "text", "tree" and "counter". "integer" matches
+// Integer and Long, and "number" matches all the numeric primitives. If Type
+// is empty, any element matches the rule.
+type Rule struct {
+	// Type is the type of the element.
+	Type string `json:"type,omitempty"`
+
+	// Properties is the rules of the members of the object.
+	Properties map[string]*Rule `json:"properties,omitempty"`
+
+	// Required is the keys of the members that the object must have.
+	Required []string `json:"required,omitempty"`
+
+	// AdditionalProperties is whether the object can have members that are not
+	// in Properties. If it is nil, the object can have them.
+	AdditionalProperties *bool `json:"additionalProperties,omitempty"`
+
+	// Items is the rule of the elements of the array.
+	Items *Rule `json:"items,omitempty"`
+
+	// Tree is the schema of the nodes of the tree.
+	Tree *crdt.TreeSchema `json:"tree,omitempty"`
+}
+
+// Parse parses the given JSON into the rule of the root. The root rule should
+// be an object rule.
+func Parse(data string) (*Rule, error) {
+	decoder := json.NewDecoder(bytes.NewReader([]byte(data)))
+	decoder.DisallowUnknownFields()
+
+	rule := &Rule{}
+	if err := decoder.Decode(rule); err != nil {
+		return nil, fmt.Errorf("%s: %w", err.Error(), ErrInvalidSchema)
+	}
+	if rule.Type != TypeObject {
+		return nil, fmt.Errorf("root should be %s: %w", TypeObject, ErrInvalidSchema)
+	}
+	if err := rule.check("$"); err != nil {
+		return nil, err
+	}
+
+	return rule, nil
+}
+
+// check checks whether this rule and its descendants are well-formed.
+func (r *Rule) check(path string) error {
+	if r == nil {
+		return fmt.Errorf("%s: empty rule: %w", path, ErrInvalidSchema)
+	}
+	if r.Type != "" && !slices.Contains(types, r.Type) {
+		return fmt.Errorf("%s: unknown type %q: %w", path, r.Type, ErrInvalidSchema)
+	}
+
+	if (r.Properties != nil || r.Required != nil || r.AdditionalProperties != nil) && r.Type != TypeObject {
+		return fmt.Errorf("%s: properties of non-object: %w", path, ErrInvalidSchema)
+	}
+	for k, prop := range r.Properties {
+		if err := prop.check(path + "." + k); err != nil {
+			return err
+		}
+	}
+
+	if r.Items != nil {
+		if r.Type != TypeArray {
+			return fmt.Errorf("%s: items of non-array: %w", path, ErrInvalidSchema)
+		}
+		if err := r.Items.check(path + "[]"); err != nil {
+			return err
+		}
+	}
+
+	if r.Tree != nil && r.Type != TypeTree {
+		return fmt.Errorf("%s: tree of non-tree: %w", path, ErrInvalidSchema)
+	}
+
+	return nil
+}
+
+// Validate validates the given root of the document with this rule.
+func (r *Rule) Validate(root *crdt.Object) error {
+	return r.validate("$", root)
+}
+
+// validate validates the given element of the given path with this rule.
+func (r *Rule) validate(path string, elem crdt.Element) error {
+	if !r.matches(elem) {
+		return fmt.Errorf("%s: expected %s: %w", path, r.Type, ErrSchemaViolation)
+	}
+
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		members := elem.Members()
+		for _, k := range r.Required {
+			if _, ok := members[k]; !ok {
+				return fmt.Errorf("%s: missing %q: %w", path, k, ErrSchemaViolation)
+			}
+		}
+
+		keys := make([]string, 0, len(members))
+		for k := range members {
+			keys = append(keys, k)
+		}
+		slices.Sort(keys)
+
+		for _, k := range keys {
+			prop, ok := r.Properties[k]
+			if !ok {
+				if r.AdditionalProperties != nil && !*r.AdditionalProperties {
+					return fmt.Errorf("%s: unexpected %q: %w", path, k, ErrSchemaViolation)
+				}
+				continue
+			}
+
+			if err := prop.validate(path+"."+k, members[k]); err != nil {
+				return err
+			}
+		}
+	case *crdt.Array:
+		if r.Items == nil {
+			return nil
+		}
+
+		for idx, element := range elem.Elements() {
+			if err := r.Items.validate(fmt.Sprintf("%s.%d", path, idx), element); err != nil {
+				return err
+			}
+		}
+	case *crdt.Tree:
+		if r.Tree == nil {
+			return nil
+		}
+
+		if err := r.Tree.Validate(elem.Root()); err != nil {
+			return fmt.Errorf("%s: %s: %w", path, err.Error(), ErrSchemaViolation)
+		}
+	}
+
+	return nil
+}
+
+// matches returns whether the type of the given element matches this rule.
+func (r *Rule) matches(elem crdt.Element) bool {
+	switch elem := elem.(type) {
+	case *crdt.Object:
+		return r.Type == "" || r.Type == TypeObject
+	case *crdt.Array:
+		return r.Type == "" || r.Type == TypeArray
+	case *crdt.Text:
+		return r.Type == "" || r.Type == TypeText
+	case *crdt.Tree:
+		return r.Type == "" || r.Type == TypeTree
+	case *crdt.Counter:
+		return r.Type == "" || r.Type == TypeCounter
+	case *crdt.Primitive:
+		switch elem.ValueType() {
+		case crdt.Null:
+			return r.Type == "" || r.Type == TypeNull
+		case crdt.Boolean:
+			return r.Type == "" || r.Type == TypeBoolean
+		case crdt.Integer, crdt.Long:
+			return r.Type == "" || r.Type == TypeInteger || r.Type == TypeNumber
+		case crdt.Double:
+			return r.Type == "" || r.Type == TypeNumber
+		case crdt.String:
+			return r.Type == "" || r.Type == TypeString
+		case crdt.Bytes:
+			return r.Type == "" || r.Type == TypeBytes
+		case crdt.Date:
+			return r.Type == "" || r.Type == TypeDate
+		}
+	}
+
+	return false
+}
@@ -0,0 +1,153 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package schema_test
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/schema"
+)
+
+func TestSchema(t *testing.T) {
+	t.Run("parse test", func(t *testing.T) {
+		_, err := schema.Parse(`{"type":"object","properties":{"todos":{"type":"array","items":{"type":"text"}}}}`)
+		assert.NoError(t, err)
+
+		_, err = schema.Parse(`{"type":"object"`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+
+		_, err = schema.Parse(`{"type":"array"}`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+
+		_, err = schema.Parse(`{"type":"object","properties":{"a":{"type":"unknown"}}}`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+
+		_, err = schema.Parse(`{"type":"object","properties":{"a":{"type":"string","items":{}}}}`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+
+		_, err = schema.Parse(`{"type":"object","pattern":"a"}`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+	})
+
+	t.Run("validate test", func(t *testing.T) {
+		rule, err := schema.Parse(`{
+			"type": "object",
+			"properties": {
+				"title": {"type": "string"},
+				"count": {"type": "integer"},
+				"content": {"type": "text"},
+				"todos": {
+					"type": "array",
+					"items": {
+						"type": "object",
+						"properties": {"done": {"type": "boolean"}},
+						"required": ["done"]
+					}
+				}
+			},
+			"required": ["title"],
+			"additionalProperties": false
+		}`)
+		assert.NoError(t, err)
+
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "hello")
+			root.SetLong("count", 1)
+			root.SetNewText("content").Edit(0, 0, "world")
+			root.SetNewArray("todos").AddNewObject().SetBool("done", false)
+			return nil
+		}))
+		assert.NoError(t, rule.Validate(doc.RootObject()))
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("todos").AddNewObject().SetString("text", "buy milk")
+			return nil
+		}))
+		err = rule.Validate(doc.RootObject())
+		assert.ErrorIs(t, err, schema.ErrSchemaViolation)
+		assert.ErrorContains(t, err, "$.todos.1")
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("todos").Delete(1)
+			root.SetInteger("title", 1)
+			return nil
+		}))
+		assert.ErrorIs(t, rule.Validate(doc.RootObject()), schema.ErrSchemaViolation)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("title")
+			return nil
+		}))
+		assert.ErrorIs(t, rule.Validate(doc.RootObject()), schema.ErrSchemaViolation)
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "hello")
+			root.SetString("extra", "value")
+			return nil
+		}))
+		assert.ErrorIs(t, rule.Validate(doc.RootObject()), schema.ErrSchemaViolation)
+	})
+
+	t.Run("validate tree test", func(t *testing.T) {
+		_, err := schema.Parse(`{"type":"object","properties":{"a":{"type":"text","tree":{"nodes":{}}}}}`)
+		assert.ErrorIs(t, err, schema.ErrInvalidSchema)
+
+		rule, err := schema.Parse(`{
+			"type": "object",
+			"properties": {
+				"content": {
+					"type": "tree",
+					"tree": {
+						"root": "doc",
+						"nodes": {
+							"doc": {"children": ["paragraph"]},
+							"paragraph": {"children": ["text"]}
+						}
+					}
+				}
+			}
+		}`)
+		assert.NoError(t, err)
+
+		doc := document.New("d1")
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("content", &json.TreeNode{
+				Type: "doc",
+				Children: []json.TreeNode{{
+					Type:     "paragraph",
+					Children: []json.TreeNode{{Type: "text", Value: "hello"}},
+				}},
+			})
+			return nil
+		}))
+		assert.NoError(t, rule.Validate(doc.RootObject()))
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("content").Edit(0, 0, &json.TreeNode{Type: "text", Value: "world"}, 0)
+			return nil
+		}))
+		err = rule.Validate(doc.RootObject())
+		assert.ErrorIs(t, err, schema.ErrSchemaViolation)
+		assert.ErrorContains(t, err, "$.content: text under doc")
+	})
+}
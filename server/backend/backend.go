@@ -26,6 +26,7 @@ import (
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/cache"
+	"github.com/yorkie-team/yorkie/pkg/document"
 	pkgtypes "github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/pkg/webhook"
 	"github.com/yorkie-team/yorkie/server/backend/background"
@@ -53,6 +54,13 @@ type Backend struct {
 	// AuthWebhookClient is used to send auth webhook.
 	AuthWebhookClient *webhook.Client[types.AuthWebhookRequest, types.AuthWebhookResponse]
 
+	// DocCache is used to cache the documents built to validate the pushed
+	// changes with the server sequence that they are built up to.
+	DocCache *cache.LRUExpireCache[types.DocRefKey, pkgtypes.Pair[
+		int64,
+		*document.InternalDocument,
+	]]
+
 	// EventWebhookClient is used to send event webhook
 	EventWebhookClient *webhook.Client[types.EventWebhookRequest, int]
 
@@ -115,9 +123,12 @@ func New(
 		},
 	)
 
-	// 03. Create pubsub, and locker.
+	// 03. Create pubsub, locker and the cache of documents.
 	locker := sync.New()
 	pubsub := pubsub.New()
+	docCache := cache.NewLRUExpireCache[types.DocRefKey, pkgtypes.Pair[int64, *document.InternalDocument]](
+		conf.DocCacheSize,
+	)
 
 	// 04. Create the background instance. The background instance is used to
 	// manage background tasks.
@@ -179,6 +190,7 @@ func New(
 
 		AuthWebhookCache:   authWebhookCache,
 		AuthWebhookClient:  authWebhookClient,
+		DocCache:           docCache,
 		EventWebhookClient: eventWebhookClient,
 
 		Locker: locker,
@@ -94,6 +94,14 @@ type Config struct {
 	// ProjectCacheTTL is the TTL value to set when caching the project metadata.
 	ProjectCacheTTL string `yaml:"ProjectCacheTTL"`
 
+	// DocCacheSize is the cache size of the documents built to validate the
+	// pushed changes.
+	DocCacheSize int `yaml:"DocCacheSize"`
+
+	// DocCacheTTL is the TTL value to set when caching the documents built to
+	// validate the pushed changes.
+	DocCacheTTL string `yaml:"DocCacheTTL"`
+
 	// Hostname is yorkie server hostname. hostname is used by metrics.
 	Hostname string `yaml:"Hostname"`
 
@@ -173,6 +181,13 @@ func (c *Config) Validate() error {
 			err,
 		)
 	}
+	if _, err := time.ParseDuration(c.DocCacheTTL); err != nil {
+		return fmt.Errorf(
+			`invalid argument "%s" for "--doc-cache-ttl" flag: %w`,
+			c.DocCacheTTL,
+			err,
+		)
+	}
 
 	return nil
 }
@@ -275,3 +290,14 @@ func (c *Config) ParseProjectCacheTTL() time.Duration {
 
 	return result
 }
+
+// ParseDocCacheTTL returns TTL for the cache of documents.
+func (c *Config) ParseDocCacheTTL() time.Duration {
+	result, err := time.ParseDuration(c.DocCacheTTL)
+	if err != nil {
+		fmt.Fprintln(os.Stderr, "parse document cache ttl: %w", err)
+		os.Exit(1)
+	}
+
+	return result
+}
@@ -33,6 +33,7 @@ func TestConfig(t *testing.T) {
 			AuthWebhookRequestTimeout:   "0ms",
 			AuthWebhookCacheTTL:         "10s",
 			ProjectCacheTTL:             "10m",
+			DocCacheTTL:                 "1m",
 			EventWebhookMaxWaitInterval: "0ms",
 			EventWebhookMinWaitInterval: "0ms",
 			EventWebhookRequestTimeout:  "0ms",
@@ -74,5 +75,9 @@ func TestConfig(t *testing.T) {
 		conf9 := validConf
 		conf9.EventWebhookRequestTimeout = "1"
 		assert.Error(t, conf9.Validate())
+
+		conf10 := validConf
+		conf10.DocCacheTTL = "1 minute"
+		assert.Error(t, conf10.Validate())
 	})
 }
@@ -19,6 +19,7 @@ package database
 import (
 	"errors"
 	"fmt"
+	gotime "time"
 
 	"google.golang.org/protobuf/proto"
 
@@ -50,6 +51,7 @@ type ChangeInfo struct {
 	Message        string                        `bson:"message"`
 	Operations     [][]byte                      `bson:"operations"`
 	PresenceChange *innerpresence.PresenceChange `bson:"presence_change"`
+	CreatedAt      gotime.Time                   `bson:"created_at"`
 }
 
 // EncodeOperations encodes the given operations into bytes array.
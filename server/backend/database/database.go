@@ -20,6 +20,7 @@ package database
 import (
 	"context"
 	"errors"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
@@ -58,6 +59,12 @@ var (
 
 	// ErrProjectNameAlreadyExists is returned when the project name already exists.
 	ErrProjectNameAlreadyExists = errors.New("project name already exists")
+
+	// ErrRevisionTagNotFound is returned when the revision tag could not be found.
+	ErrRevisionTagNotFound = errors.New("revision tag not found")
+
+	// ErrRevisionTagAlreadyExists is returned when the revision tag already exists.
+	ErrRevisionTagAlreadyExists = errors.New("revision tag already exists")
 )
 
 // Database represents database which reads or saves Yorkie data.
@@ -213,7 +220,8 @@ type Database interface {
 	) error
 
 	// PurgeStaleChanges delete changes before the smallest in `syncedseqs` to
-	// save storage.
+	// save storage. The changes needed to build the tagged revisions from
+	// their closest snapshots are not deleted.
 	PurgeStaleChanges(
 		ctx context.Context,
 		docRefKey types.DocRefKey,
@@ -227,6 +235,14 @@ type Database interface {
 		serverSeq int64,
 	) (*ChangeInfo, error)
 
+	// FindLatestChangeInfoBeforeTime returns the latest change stored at or
+	// before the given time.
+	FindLatestChangeInfoBeforeTime(
+		ctx context.Context,
+		docRefKey types.DocRefKey,
+		createdAt gotime.Time,
+	) (*ChangeInfo, error)
+
 	// FindChangesBetweenServerSeqs returns the changes between two server sequences.
 	FindChangesBetweenServerSeqs(
 		ctx context.Context,
@@ -264,6 +280,31 @@ type Database interface {
 		includeSnapshot bool,
 	) (*SnapshotInfo, error)
 
+	// CreateRevisionTagInfo creates a new tag of the given name that points to
+	// the given server sequence of the document.
+	CreateRevisionTagInfo(
+		ctx context.Context,
+		docRefKey types.DocRefKey,
+		name string,
+		serverSeq int64,
+		message string,
+		author string,
+	) (*RevisionTagInfo, error)
+
+	// FindRevisionTagInfos returns the tags of the given document in the order
+	// of the server sequence.
+	FindRevisionTagInfos(
+		ctx context.Context,
+		docRefKey types.DocRefKey,
+	) ([]*RevisionTagInfo, error)
+
+	// DeleteRevisionTagInfo deletes the tag of the given name of the document.
+	DeleteRevisionTagInfo(
+		ctx context.Context,
+		docRefKey types.DocRefKey,
+		name string,
+	) error
+
 	// FindMinSyncedSeqInfo finds the minimum synced sequence info.
 	FindMinSyncedSeqInfo(
 		ctx context.Context,
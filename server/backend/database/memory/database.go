@@ -20,6 +20,7 @@ package memory
 import (
 	"context"
 	"fmt"
+	"math"
 	gotime "time"
 
 	"github.com/hashicorp/go-memdb"
@@ -898,6 +899,7 @@ func (d *DB) CreateChangeInfos(
 	txn := d.db.Txn(true)
 	defer txn.Abort()
 
+	now := gotime.Now()
 	for _, cn := range changes {
 		encodedOperations, err := database.EncodeOperations(cn.Operations())
 		if err != nil {
@@ -916,6 +918,7 @@ func (d *DB) CreateChangeInfos(
 			Message:        cn.Message(),
 			Operations:     encodedOperations,
 			PresenceChange: cn.PresenceChange(),
+			CreatedAt:      now,
 		}); err != nil {
 			return fmt.Errorf("create change: %w", err)
 		}
@@ -938,7 +941,6 @@ func (d *DB) CreateChangeInfos(
 		return fmt.Errorf("%s: %w", docInfo.ID, database.ErrConflictOnUpdate)
 	}
 
-	now := gotime.Now()
 	loadedDocInfo.ServerSeq = docInfo.ServerSeq
 
 	for _, cn := range changes {
@@ -990,23 +992,42 @@ func (d *DB) PurgeStaleChanges(
 		return nil
 	}
 
+	// Keep the changes after the snapshot closest to the oldest tagged
+	// revision because they are needed to build the tagged revisions.
+	purgeBefore := minSyncedServerSeq
+	snapshotServerSeq, err := d.findTaggedSnapshotServerSeq(txn, docRefKey)
+	if err != nil {
+		return err
+	}
+	if snapshotServerSeq != change.MaxServerSeq && snapshotServerSeq+1 < purgeBefore {
+		purgeBefore = snapshotServerSeq + 1
+	}
+
 	// Delete all changes before the smallest server seq.
 	iterator, err := txn.ReverseLowerBound(
 		tblChanges,
 		"doc_id_server_seq",
 		docRefKey.DocID.String(),
-		minSyncedServerSeq,
+		purgeBefore,
 	)
 	if err != nil {
-		return fmt.Errorf("fetch changes before %d: %w", minSyncedServerSeq, err)
+		return fmt.Errorf("fetch changes before %d: %w", purgeBefore, err)
 	}
 
 	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
 		info := raw.(*database.ChangeInfo)
+		if info.DocID != docRefKey.DocID {
+			break
+		}
+		if info.ServerSeq >= purgeBefore {
+			continue
+		}
+
 		if err = txn.Delete(tblChanges, info); err != nil {
 			return fmt.Errorf("delete change %s: %w", info.ID, err)
 		}
 	}
+	txn.Commit()
 	return nil
 }
 
@@ -1041,6 +1062,39 @@ func (d *DB) FindLatestChangeInfoByActor(
 	return nil, database.ErrChangeNotFound
 }
 
+// FindLatestChangeInfoBeforeTime returns the latest change stored at or
+// before the given time.
+func (d *DB) FindLatestChangeInfoBeforeTime(
+	_ context.Context,
+	docRefKey types.DocRefKey,
+	createdAt gotime.Time,
+) (*database.ChangeInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.ReverseLowerBound(
+		tblChanges,
+		"doc_id_server_seq",
+		docRefKey.DocID.String(),
+		int64(math.MaxInt64),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("fetch changes of %s: %w", docRefKey, err)
+	}
+
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.ChangeInfo)
+		if info.DocID != docRefKey.DocID {
+			break
+		}
+		if !info.CreatedAt.After(createdAt) {
+			return info, nil
+		}
+	}
+
+	return nil, fmt.Errorf("%s: %w", docRefKey, database.ErrChangeNotFound)
+}
+
 // FindChangesBetweenServerSeqs returns the changes between two server sequences.
 func (d *DB) FindChangesBetweenServerSeqs(
 	ctx context.Context,
@@ -1201,6 +1255,101 @@ func (d *DB) FindClosestSnapshotInfo(
 	return snapshotInfo, nil
 }
 
+// CreateRevisionTagInfo creates a new tag of the given name that points to
+// the given server sequence of the document.
+func (d *DB) CreateRevisionTagInfo(
+	_ context.Context,
+	docRefKey types.DocRefKey,
+	name string,
+	serverSeq int64,
+	message string,
+	author string,
+) (*database.RevisionTagInfo, error) {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	existing, err := txn.First(tblRevisionTags, "doc_id_name", docRefKey.DocID.String(), name)
+	if err != nil {
+		return nil, fmt.Errorf("find revision tag by name: %w", err)
+	}
+	if existing != nil {
+		return nil, fmt.Errorf("%s: %w", name, database.ErrRevisionTagAlreadyExists)
+	}
+
+	info := &database.RevisionTagInfo{
+		ID:        newID(),
+		ProjectID: docRefKey.ProjectID,
+		DocID:     docRefKey.DocID,
+		Name:      name,
+		ServerSeq: serverSeq,
+		Message:   message,
+		Author:    author,
+		CreatedAt: gotime.Now(),
+	}
+	if err := txn.Insert(tblRevisionTags, info); err != nil {
+		return nil, fmt.Errorf("create revision tag: %w", err)
+	}
+	txn.Commit()
+
+	return info.DeepCopy(), nil
+}
+
+// FindRevisionTagInfos returns the tags of the given document in the order of
+// the server sequence.
+func (d *DB) FindRevisionTagInfos(
+	_ context.Context,
+	docRefKey types.DocRefKey,
+) ([]*database.RevisionTagInfo, error) {
+	txn := d.db.Txn(false)
+	defer txn.Abort()
+
+	iterator, err := txn.LowerBound(
+		tblRevisionTags,
+		"doc_id_server_seq",
+		docRefKey.DocID.String(),
+		int64(0),
+	)
+	if err != nil {
+		return nil, fmt.Errorf("fetch revision tags of %s: %w", docRefKey.DocID, err)
+	}
+
+	var infos []*database.RevisionTagInfo
+	for raw := iterator.Next(); raw != nil; raw = iterator.Next() {
+		info := raw.(*database.RevisionTagInfo)
+		if info.DocID != docRefKey.DocID {
+			break
+		}
+		infos = append(infos, info.DeepCopy())
+	}
+
+	return infos, nil
+}
+
+// DeleteRevisionTagInfo deletes the tag of the given name of the document.
+func (d *DB) DeleteRevisionTagInfo(
+	_ context.Context,
+	docRefKey types.DocRefKey,
+	name string,
+) error {
+	txn := d.db.Txn(true)
+	defer txn.Abort()
+
+	raw, err := txn.First(tblRevisionTags, "doc_id_name", docRefKey.DocID.String(), name)
+	if err != nil {
+		return fmt.Errorf("find revision tag by name: %w", err)
+	}
+	if raw == nil {
+		return fmt.Errorf("%s: %w", name, database.ErrRevisionTagNotFound)
+	}
+
+	if err := txn.Delete(tblRevisionTags, raw); err != nil {
+		return fmt.Errorf("delete revision tag %s: %w", name, err)
+	}
+	txn.Commit()
+
+	return nil
+}
+
 // FindMinSyncedSeqInfo finds the minimum synced sequence info.
 func (d *DB) FindMinSyncedSeqInfo(
 	_ context.Context,
@@ -1607,6 +1756,44 @@ func (d *DB) findTicketByServerSeq(
 	), nil
 }
 
+// findTaggedSnapshotServerSeq returns the server sequence of the snapshot
+// closest to the oldest tagged revision of the given document. If the
+// document has no tags, it returns change.MaxServerSeq.
+func (d *DB) findTaggedSnapshotServerSeq(
+	txn *memdb.Txn,
+	docRefKey types.DocRefKey,
+) (int64, error) {
+	raw, err := txn.LowerBound(
+		tblRevisionTags,
+		"doc_id_server_seq",
+		docRefKey.DocID.String(),
+		int64(0),
+	)
+	if err != nil {
+		return 0, fmt.Errorf("fetch revision tags of %s: %w", docRefKey.DocID, err)
+	}
+	tag, ok := raw.Next().(*database.RevisionTagInfo)
+	if !ok || tag.DocID != docRefKey.DocID {
+		return change.MaxServerSeq, nil
+	}
+
+	iterator, err := txn.ReverseLowerBound(
+		tblSnapshots,
+		"doc_id_server_seq",
+		docRefKey.DocID.String(),
+		tag.ServerSeq,
+	)
+	if err != nil {
+		return 0, fmt.Errorf("fetch snapshots before %d: %w", tag.ServerSeq, err)
+	}
+	snapshot, ok := iterator.Next().(*database.SnapshotInfo)
+	if !ok || snapshot.DocID != docRefKey.DocID {
+		return change.InitialServerSeq, nil
+	}
+
+	return snapshot.ServerSeq, nil
+}
+
 func newID() types.ID {
 	return types.ID(primitive.NewObjectID().Hex())
 }
@@ -115,4 +115,12 @@ func TestDB(t *testing.T) {
 	t.Run("IsDocumentAttached test", func(t *testing.T) {
 		testcases.RunIsDocumentAttachedTest(t, db, projectID)
 	})
+
+	t.Run("RevisionTags test", func(t *testing.T) {
+		testcases.RunRevisionTagsTest(t, db, projectID)
+	})
+
+	t.Run("PurgeStaleChanges test", func(t *testing.T) {
+		testcases.RunPurgeStaleChangesTest(t, db, projectID)
+	})
 }
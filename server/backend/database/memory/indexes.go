@@ -27,6 +27,7 @@ var (
 	tblSnapshots      = "snapshots"
 	tblSyncedSeqs     = "syncedseqs"
 	tblVersionVectors = "versionvectors"
+	tblRevisionTags   = "revisiontags"
 )
 
 var schema = &memdb.DBSchema{
@@ -269,5 +270,34 @@ var schema = &memdb.DBSchema{
 				},
 			},
 		},
+		tblRevisionTags: {
+			Name: tblRevisionTags,
+			Indexes: map[string]*memdb.IndexSchema{
+				"id": {
+					Name:    "id",
+					Unique:  true,
+					Indexer: &memdb.StringFieldIndex{Field: "ID"},
+				},
+				"doc_id_name": {
+					Name:   "doc_id_name",
+					Unique: true,
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "DocID"},
+							&memdb.StringFieldIndex{Field: "Name"},
+						},
+					},
+				},
+				"doc_id_server_seq": {
+					Name: "doc_id_server_seq",
+					Indexer: &memdb.CompoundIndex{
+						Indexes: []memdb.Indexer{
+							&memdb.StringFieldIndex{Field: "DocID"},
+							&memdb.IntFieldIndex{Field: "ServerSeq"},
+						},
+					},
+				},
+			},
+		},
 	},
 }
@@ -866,6 +866,7 @@ func (c *Client) CreateChangeInfos(
 ) error {
 	docRefKey := docInfo.RefKey()
 
+	now := gotime.Now()
 	var models []mongo.WriteModel
 	for _, cn := range changes {
 		encodedOperations, err := database.EncodeOperations(cn.Operations())
@@ -885,6 +886,7 @@ func (c *Client) CreateChangeInfos(
 			"message":         cn.Message(),
 			"operations":      encodedOperations,
 			"presence_change": cn.PresenceChange(),
+			"created_at":      now,
 		}}).SetUpsert(true))
 	}
 
@@ -900,7 +902,6 @@ func (c *Client) CreateChangeInfos(
 		}
 	}
 
-	now := gotime.Now()
 	updateFields := bson.M{
 		"server_seq": docInfo.ServerSeq,
 	}
@@ -937,7 +938,8 @@ func (c *Client) CreateChangeInfos(
 }
 
 // PurgeStaleChanges delete changes before the smallest in `syncedseqs` to
-// save storage.
+// save storage. The changes needed to build the tagged revisions from their
+// closest snapshots are not deleted.
 func (c *Client) PurgeStaleChanges(
 	ctx context.Context,
 	docRefKey types.DocRefKey,
@@ -962,6 +964,35 @@ func (c *Client) PurgeStaleChanges(
 	if err := result.Decode(&minSyncedSeqInfo); err != nil {
 		return fmt.Errorf("decode syncedseq: %w", err)
 	}
+	purgeBefore := minSyncedSeqInfo.ServerSeq
+
+	// Keep the changes after the snapshot closest to the oldest tagged
+	// revision because they are needed to build the tagged revisions.
+	result = c.collection(ColRevisionTags).FindOne(
+		ctx,
+		bson.M{
+			"project_id": docRefKey.ProjectID,
+			"doc_id":     docRefKey.DocID,
+		},
+		options.FindOne().SetSort(bson.M{"server_seq": 1}),
+	)
+	if result.Err() != nil && result.Err() != mongo.ErrNoDocuments {
+		return fmt.Errorf("find revision tag: %w", result.Err())
+	}
+	if result.Err() == nil {
+		tagInfo := database.RevisionTagInfo{}
+		if err := result.Decode(&tagInfo); err != nil {
+			return fmt.Errorf("decode revision tag: %w", err)
+		}
+
+		snapshotInfo, err := c.FindClosestSnapshotInfo(ctx, docRefKey, tagInfo.ServerSeq, false)
+		if err != nil {
+			return err
+		}
+		if snapshotInfo.ServerSeq+1 < purgeBefore {
+			purgeBefore = snapshotInfo.ServerSeq + 1
+		}
+	}
 
 	// Delete all changes before the smallest server seq.
 	if _, err := c.collection(ColChanges).DeleteMany(
@@ -969,7 +1000,7 @@ func (c *Client) PurgeStaleChanges(
 		bson.M{
 			"project_id": docRefKey.ProjectID,
 			"doc_id":     docRefKey.DocID,
-			"server_seq": bson.M{"$lt": minSyncedSeqInfo.ServerSeq},
+			"server_seq": bson.M{"$lt": purgeBefore},
 		},
 		options.Delete(),
 	); err != nil {
@@ -1014,6 +1045,39 @@ func (c *Client) FindLatestChangeInfoByActor(
 	return changeInfo, nil
 }
 
+// FindLatestChangeInfoBeforeTime returns the latest change stored at or
+// before the given time.
+func (c *Client) FindLatestChangeInfoBeforeTime(
+	ctx context.Context,
+	docRefKey types.DocRefKey,
+	createdAt gotime.Time,
+) (*database.ChangeInfo, error) {
+	option := options.FindOne().SetSort(bson.M{
+		"server_seq": -1,
+	})
+
+	result := c.collection(ColChanges).FindOne(ctx, bson.M{
+		"project_id": docRefKey.ProjectID,
+		"doc_id":     docRefKey.DocID,
+		"created_at": bson.M{
+			"$lte": createdAt,
+		},
+	}, option)
+	if result.Err() == mongo.ErrNoDocuments {
+		return nil, fmt.Errorf("%s: %w", docRefKey, database.ErrChangeNotFound)
+	}
+	if result.Err() != nil {
+		return nil, fmt.Errorf("find change: %w", result.Err())
+	}
+
+	changeInfo := &database.ChangeInfo{}
+	if err := result.Decode(changeInfo); err != nil {
+		return nil, fmt.Errorf("decode change: %w", err)
+	}
+
+	return changeInfo, nil
+}
+
 // FindChangesBetweenServerSeqs returns the changes between two server sequences.
 func (c *Client) FindChangesBetweenServerSeqs(
 	ctx context.Context,
@@ -1159,6 +1223,89 @@ func (c *Client) FindClosestSnapshotInfo(
 	return snapshotInfo, nil
 }
 
+// CreateRevisionTagInfo creates a new tag of the given name that points to
+// the given server sequence of the document.
+func (c *Client) CreateRevisionTagInfo(
+	ctx context.Context,
+	docRefKey types.DocRefKey,
+	name string,
+	serverSeq int64,
+	message string,
+	author string,
+) (*database.RevisionTagInfo, error) {
+	info := &database.RevisionTagInfo{
+		ProjectID: docRefKey.ProjectID,
+		DocID:     docRefKey.DocID,
+		Name:      name,
+		ServerSeq: serverSeq,
+		Message:   message,
+		Author:    author,
+		CreatedAt: gotime.Now(),
+	}
+	result, err := c.collection(ColRevisionTags).InsertOne(ctx, bson.M{
+		"project_id": info.ProjectID,
+		"doc_id":     info.DocID,
+		"name":       info.Name,
+		"server_seq": info.ServerSeq,
+		"message":    info.Message,
+		"author":     info.Author,
+		"created_at": info.CreatedAt,
+	})
+	if err != nil {
+		if mongo.IsDuplicateKeyError(err) {
+			return nil, fmt.Errorf("%s: %w", name, database.ErrRevisionTagAlreadyExists)
+		}
+
+		return nil, fmt.Errorf("create revision tag: %w", err)
+	}
+
+	info.ID = types.ID(result.InsertedID.(primitive.ObjectID).Hex())
+	return info, nil
+}
+
+// FindRevisionTagInfos returns the tags of the given document in the order of
+// the server sequence.
+func (c *Client) FindRevisionTagInfos(
+	ctx context.Context,
+	docRefKey types.DocRefKey,
+) ([]*database.RevisionTagInfo, error) {
+	cursor, err := c.collection(ColRevisionTags).Find(ctx, bson.M{
+		"project_id": docRefKey.ProjectID,
+		"doc_id":     docRefKey.DocID,
+	}, options.Find().SetSort(bson.M{"server_seq": 1}))
+	if err != nil {
+		return nil, fmt.Errorf("find revision tags: %w", err)
+	}
+
+	var infos []*database.RevisionTagInfo
+	if err := cursor.All(ctx, &infos); err != nil {
+		return nil, fmt.Errorf("fetch revision tags: %w", err)
+	}
+
+	return infos, nil
+}
+
+// DeleteRevisionTagInfo deletes the tag of the given name of the document.
+func (c *Client) DeleteRevisionTagInfo(
+	ctx context.Context,
+	docRefKey types.DocRefKey,
+	name string,
+) error {
+	result, err := c.collection(ColRevisionTags).DeleteOne(ctx, bson.M{
+		"project_id": docRefKey.ProjectID,
+		"doc_id":     docRefKey.DocID,
+		"name":       name,
+	})
+	if err != nil {
+		return fmt.Errorf("delete revision tag: %w", err)
+	}
+	if result.DeletedCount == 0 {
+		return fmt.Errorf("%s: %w", name, database.ErrRevisionTagNotFound)
+	}
+
+	return nil
+}
+
 // FindMinSyncedSeqInfo finds the minimum synced sequence info.
 func (c *Client) FindMinSyncedSeqInfo(
 	ctx context.Context,
@@ -132,4 +132,12 @@ func TestClient(t *testing.T) {
 	t.Run("IsDocumentAttached test", func(t *testing.T) {
 		testcases.RunIsDocumentAttachedTest(t, cli, dummyProjectID)
 	})
+
+	t.Run("RevisionTags test", func(t *testing.T) {
+		testcases.RunRevisionTagsTest(t, cli, dummyProjectID)
+	})
+
+	t.Run("PurgeStaleChanges test", func(t *testing.T) {
+		testcases.RunPurgeStaleChangesTest(t, cli, dummyProjectID)
+	})
 }
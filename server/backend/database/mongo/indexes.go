@@ -42,6 +42,8 @@ const (
 	ColSyncedSeqs = "syncedseqs"
 	// ColVersionVectors represents the versionvector collection in the database.
 	ColVersionVectors = "versionvectors"
+	// ColRevisionTags represents the revisiontags collection in the database.
+	ColRevisionTags = "revisiontags"
 )
 
 // Collections represents the list of all collections in the database.
@@ -53,6 +55,7 @@ var Collections = []string{
 	ColChanges,
 	ColSnapshots,
 	ColSyncedSeqs,
+	ColRevisionTags,
 }
 
 type collectionInfo struct {
@@ -177,6 +180,22 @@ var collectionInfos = []collectionInfo{
 			},
 			Options: options.Index().SetUnique(true),
 		}},
+	}, {
+		name: ColRevisionTags,
+		indexes: []mongo.IndexModel{{
+			Keys: bsonx.Doc{
+				{Key: "doc_id", Value: bsonx.Int32(1)}, // shard key
+				{Key: "project_id", Value: bsonx.Int32(1)},
+				{Key: "name", Value: bsonx.Int32(1)},
+			},
+			Options: options.Index().SetUnique(true),
+		}, {
+			Keys: bsonx.Doc{
+				{Key: "doc_id", Value: bsonx.Int32(1)}, // shard key
+				{Key: "project_id", Value: bsonx.Int32(1)},
+				{Key: "server_seq", Value: bsonx.Int32(1)},
+			},
+		}},
 	},
 }
 
@@ -67,6 +67,10 @@ type ProjectInfo struct {
 	// specific project are considered deactivate for housekeeping.
 	ClientDeactivateThreshold string `bson:"client_deactivate_threshold"`
 
+	// DocumentSchema is the schema that the root of documents in this project
+	// should follow. If it is empty, the root is not validated.
+	DocumentSchema string `bson:"document_schema"`
+
 	// CreatedAt is the time when the project was created.
 	CreatedAt time.Time `bson:"created_at"`
 
@@ -103,6 +107,7 @@ func (i *ProjectInfo) DeepCopy() *ProjectInfo {
 		EventWebhookURL:           i.EventWebhookURL,
 		EventWebhookEvents:        i.EventWebhookEvents,
 		ClientDeactivateThreshold: i.ClientDeactivateThreshold,
+		DocumentSchema:            i.DocumentSchema,
 		CreatedAt:                 i.CreatedAt,
 		UpdatedAt:                 i.UpdatedAt,
 	}
@@ -128,6 +133,9 @@ func (i *ProjectInfo) UpdateFields(fields *types.UpdatableProjectFields) {
 	if fields.ClientDeactivateThreshold != nil {
 		i.ClientDeactivateThreshold = *fields.ClientDeactivateThreshold
 	}
+	if fields.DocumentSchema != nil {
+		i.DocumentSchema = *fields.DocumentSchema
+	}
 }
 
 // ToProject converts the ProjectInfo to the Project.
@@ -141,6 +149,7 @@ func (i *ProjectInfo) ToProject() *types.Project {
 		EventWebhookURL:           i.EventWebhookURL,
 		EventWebhookEvents:        i.EventWebhookEvents,
 		ClientDeactivateThreshold: i.ClientDeactivateThreshold,
+		DocumentSchema:            i.DocumentSchema,
 		PublicKey:                 i.PublicKey,
 		SecretKey:                 i.SecretKey,
 		CreatedAt:                 i.CreatedAt,
@@ -36,6 +36,7 @@ func TestProjectInfo(t *testing.T) {
 		testMethods := []string{"testMethod"}
 		testEvents := []string{"testEvent"}
 		testClientDeactivateThreshold := "2h"
+		testDocumentSchema := `{"type":"object"}`
 
 		project.UpdateFields(&types.UpdatableProjectFields{Name: &testName})
 		assert.Equal(t, testName, project.Name)
@@ -58,5 +59,8 @@ func TestProjectInfo(t *testing.T) {
 			ClientDeactivateThreshold: &testClientDeactivateThreshold,
 		})
 		assert.Equal(t, testClientDeactivateThreshold, project.ClientDeactivateThreshold)
+
+		project.UpdateFields(&types.UpdatableProjectFields{DocumentSchema: &testDocumentSchema})
+		assert.Equal(t, testDocumentSchema, project.DocumentSchema)
 	})
 }
@@ -0,0 +1,88 @@
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package database
+
+import (
+	gotime "time"
+
+	"github.com/yorkie-team/yorkie/api/types"
+)
+
+// RevisionTagInfo is a structure representing information of the tag that
+// names a revision of the document.
+type RevisionTagInfo struct {
+	// ID is the unique ID of the tag.
+	ID types.ID `bson:"_id"`
+
+	// ProjectID is the ID of the project which the tag belongs to.
+	ProjectID types.ID `bson:"project_id"`
+
+	// DocID is the ID of the document which the tag belongs to.
+	DocID types.ID `bson:"doc_id"`
+
+	// Name is the name of the tag. It is unique in the document.
+	Name string `bson:"name"`
+
+	// ServerSeq is the server sequence of the revision which the tag points to.
+	ServerSeq int64 `bson:"server_seq"`
+
+	// Message is the message of the tag.
+	Message string `bson:"message"`
+
+	// Author is the name of the user who created the tag.
+	Author string `bson:"author"`
+
+	// CreatedAt is the time when the tag is created.
+	CreatedAt gotime.Time `bson:"created_at"`
+}
+
+// DeepCopy returns a deep copy of the RevisionTagInfo.
+func (i *RevisionTagInfo) DeepCopy() *RevisionTagInfo {
+	if i == nil {
+		return nil
+	}
+
+	return &RevisionTagInfo{
+		ID:        i.ID,
+		ProjectID: i.ProjectID,
+		DocID:     i.DocID,
+		Name:      i.Name,
+		ServerSeq: i.ServerSeq,
+		Message:   i.Message,
+		Author:    i.Author,
+		CreatedAt: i.CreatedAt,
+	}
+}
+
+// RefKey returns the refKey of the document which the tag belongs to.
+func (i *RevisionTagInfo) RefKey() types.DocRefKey {
+	return types.DocRefKey{
+		ProjectID: i.ProjectID,
+		DocID:     i.DocID,
+	}
+}
+
+// ToRevisionTag converts the RevisionTagInfo to the RevisionTag.
+func (i *RevisionTagInfo) ToRevisionTag() *types.RevisionTag {
+	return &types.RevisionTag{
+		Name:      i.Name,
+		ServerSeq: i.ServerSeq,
+		Message:   i.Message,
+		Author:    i.Author,
+		CreatedAt: i.CreatedAt,
+	}
+}
@@ -596,6 +596,14 @@ func RunFindLatestChangeInfoTest(t *testing.T,
 		)
 		assert.NoError(t, err)
 		assert.Equal(t, maxLamport, latestChangeInfo.Lamport)
+
+		// 05. Find the latest change info stored at or before the given time.
+		latestChangeInfo, err = db.FindLatestChangeInfoBeforeTime(ctx, docRefKey, gotime.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, docInfo.ServerSeq, latestChangeInfo.ServerSeq)
+
+		_, err = db.FindLatestChangeInfoBeforeTime(ctx, docRefKey, gotime.Now().Add(-gotime.Hour))
+		assert.ErrorIs(t, err, database.ErrChangeNotFound)
 	})
 }
 
@@ -1644,6 +1652,162 @@ func RunFindDeactivateCandidatesPerProjectTest(t *testing.T, db database.Databas
 	})
 }
 
+// RunRevisionTagsTest runs the revision tag tests for the given db.
+func RunRevisionTagsTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("create, find and delete revision tags test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), map[string]string{"userID": t.Name()})
+		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, clientInfo.RefKey(), docKey, true)
+		docRefKey := docInfo.RefKey()
+
+		_, err := db.CreateRevisionTagInfo(ctx, docRefKey, "v2", 7, "second", "admin")
+		assert.NoError(t, err)
+		info, err := db.CreateRevisionTagInfo(ctx, docRefKey, "v1", 5, "first", "admin")
+		assert.NoError(t, err)
+		assert.Equal(t, "v1", info.Name)
+		assert.Equal(t, int64(5), info.ServerSeq)
+		assert.Equal(t, "first", info.Message)
+		assert.Equal(t, "admin", info.Author)
+
+		_, err = db.CreateRevisionTagInfo(ctx, docRefKey, "v1", 6, "", "admin")
+		assert.ErrorIs(t, err, database.ErrRevisionTagAlreadyExists)
+
+		infos, err := db.FindRevisionTagInfos(ctx, docRefKey)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 2)
+		assert.Equal(t, "v1", infos[0].Name)
+		assert.Equal(t, "v2", infos[1].Name)
+
+		assert.NoError(t, db.DeleteRevisionTagInfo(ctx, docRefKey, "v1"))
+		assert.ErrorIs(t, db.DeleteRevisionTagInfo(ctx, docRefKey, "v1"), database.ErrRevisionTagNotFound)
+
+		infos, err = db.FindRevisionTagInfos(ctx, docRefKey)
+		assert.NoError(t, err)
+		assert.Len(t, infos, 1)
+		assert.Equal(t, "v2", infos[0].Name)
+	})
+
+	t.Run("purge stale changes with revision tags test", func(t *testing.T) {
+		ctx := context.Background()
+		docKey := key.Key(fmt.Sprintf("tests$%s", t.Name()))
+
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), map[string]string{"userID": t.Name()})
+		docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, clientInfo.RefKey(), docKey, true)
+		docRefKey := docInfo.RefKey()
+		assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+		assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+		// 01. store 10 changes and a snapshot at the server sequence 3.
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+		doc := document.New(key.Key(t.Name()))
+		doc.SetActor(actorID)
+		for idx := 0; idx < 10; idx++ {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetInteger("k", idx)
+				return nil
+			}))
+		}
+		pack := doc.CreateChangePack()
+		for idx, c := range pack.Changes {
+			c.SetServerSeq(int64(idx + 1))
+		}
+		assert.NoError(t, db.CreateChangeInfos(ctx, projectID, docInfo, 0, pack.Changes, false))
+
+		snapshotDoc := document.New(key.Key(t.Name()))
+		assert.NoError(t, snapshotDoc.ApplyChangePack(change.NewPack(
+			snapshotDoc.Key(),
+			snapshotDoc.Checkpoint().NextServerSeq(3),
+			nil,
+			snapshotDoc.VersionVector(),
+			nil,
+		)))
+		assert.NoError(t, db.CreateSnapshotInfo(ctx, docRefKey, snapshotDoc.InternalDocument()))
+
+		// 02. purge the changes with the tag at the server sequence 5.
+		assert.NoError(t, db.UpdateSyncedSeq(ctx, clientInfo, docRefKey, 8))
+		_, err := db.CreateRevisionTagInfo(ctx, docRefKey, "v1", 5, "", "admin")
+		assert.NoError(t, err)
+		assert.NoError(t, db.PurgeStaleChanges(ctx, docRefKey))
+
+		changes, err := db.FindChangesBetweenServerSeqs(ctx, docRefKey, 1, 3)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 0)
+		changes, err = db.FindChangesBetweenServerSeqs(ctx, docRefKey, 4, 7)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 4)
+
+		// 03. purge the changes without the tag.
+		assert.NoError(t, db.DeleteRevisionTagInfo(ctx, docRefKey, "v1"))
+		assert.NoError(t, db.PurgeStaleChanges(ctx, docRefKey))
+
+		changes, err = db.FindChangesBetweenServerSeqs(ctx, docRefKey, 4, 7)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 0)
+	})
+}
+
+// RunPurgeStaleChangesTest runs the PurgeStaleChanges test for the given db.
+func RunPurgeStaleChangesTest(t *testing.T, db database.Database, projectID types.ID) {
+	t.Run("purge stale changes of multiple documents test", func(t *testing.T) {
+		ctx := context.Background()
+
+		clientInfo, _ := db.ActivateClient(ctx, projectID, t.Name(), map[string]string{"userID": t.Name()})
+		bytesID, _ := clientInfo.ID.Bytes()
+		actorID, _ := time.ActorIDFromBytes(bytesID)
+
+		// 01. store 10 changes to each of the two documents.
+		var docRefKeys []types.DocRefKey
+		for _, suffix := range []string{"1", "2"} {
+			docKey := key.Key(fmt.Sprintf("tests$%s-%s", t.Name(), suffix))
+			docInfo, _ := db.FindDocInfoByKeyAndOwner(ctx, clientInfo.RefKey(), docKey, true)
+			assert.NoError(t, clientInfo.AttachDocument(docInfo.ID, false))
+			assert.NoError(t, db.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo))
+
+			doc := document.New(docKey)
+			doc.SetActor(actorID)
+			for idx := 0; idx < 10; idx++ {
+				assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+					root.SetInteger("k", idx)
+					return nil
+				}))
+			}
+			pack := doc.CreateChangePack()
+			for idx, c := range pack.Changes {
+				c.SetServerSeq(int64(idx + 1))
+			}
+			assert.NoError(t, db.CreateChangeInfos(ctx, projectID, docInfo, 0, pack.Changes, false))
+			docRefKeys = append(docRefKeys, docInfo.RefKey())
+		}
+
+		// 02. purge the changes of the second document only.
+		assert.NoError(t, db.UpdateSyncedSeq(ctx, clientInfo, docRefKeys[0], 10))
+		assert.NoError(t, db.UpdateSyncedSeq(ctx, clientInfo, docRefKeys[1], 6))
+		assert.NoError(t, db.PurgeStaleChanges(ctx, docRefKeys[1]))
+
+		changes, err := db.FindChangesBetweenServerSeqs(ctx, docRefKeys[1], 1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 5)
+		assert.Equal(t, int64(6), changes[0].ServerSeq())
+
+		changes, err = db.FindChangesBetweenServerSeqs(ctx, docRefKeys[0], 1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 10)
+
+		// 03. purge the changes of the first document.
+		assert.NoError(t, db.PurgeStaleChanges(ctx, docRefKeys[0]))
+
+		changes, err = db.FindChangesBetweenServerSeqs(ctx, docRefKeys[0], 1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 1)
+		changes, err = db.FindChangesBetweenServerSeqs(ctx, docRefKeys[1], 1, 10)
+		assert.NoError(t, err)
+		assert.Len(t, changes, 5)
+	})
+}
+
 // AssertKeys checks the equivalence between the provided expectedKeys and the keys in the given infos.
 func AssertKeys(t *testing.T, expectedKeys []key.Key, infos []*database.DocInfo) {
 	var keys []key.Key
@@ -19,11 +19,12 @@ package messagebroker
 
 import (
 	"context"
-	"encoding/json"
+	gojson "encoding/json"
 	"fmt"
 	"time"
 
 	"github.com/yorkie-team/yorkie/api/types/events"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/server/logging"
 )
 
@@ -43,7 +44,28 @@ type UserEventMessage struct {
 
 // Marshal marshals the user event message to JSON.
 func (m UserEventMessage) Marshal() ([]byte, error) {
-	encoded, err := json.Marshal(m)
+	encoded, err := gojson.Marshal(m)
+	if err != nil {
+		return nil, fmt.Errorf("marshal: %w", err)
+	}
+
+	return encoded, nil
+}
+
+// DocumentPatchMessage represents a message for the JSON Patch(RFC 6902) of a
+// change pushed to the document.
+type DocumentPatchMessage struct {
+	ProjectID   string                `json:"project_id"`
+	DocumentKey string                `json:"document_key"`
+	ActorID     string                `json:"actor_id"`
+	ServerSeq   int64                 `json:"server_seq"`
+	Patches     []json.PatchOperation `json:"patches"`
+	Timestamp   time.Time             `json:"timestamp"`
+}
+
+// Marshal marshals the document patch message to JSON.
+func (m DocumentPatchMessage) Marshal() ([]byte, error) {
+	encoded, err := gojson.Marshal(m)
 	if err != nil {
 		return nil, fmt.Errorf("marshal: %w", err)
 	}
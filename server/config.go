@@ -79,6 +79,9 @@ const (
 	DefaultProjectCacheSize = 256
 	DefaultProjectCacheTTL  = 10 * time.Minute
 
+	DefaultDocCacheSize = 128
+	DefaultDocCacheTTL  = time.Minute
+
 	DefaultHostname    = ""
 	DefaultGatewayAddr = "localhost:8080"
 )
@@ -240,6 +243,14 @@ func (c *Config) ensureDefaultValue() {
 		c.Backend.ProjectCacheTTL = DefaultProjectCacheTTL.String()
 	}
 
+	if c.Backend.DocCacheSize == 0 {
+		c.Backend.DocCacheSize = DefaultDocCacheSize
+	}
+
+	if c.Backend.DocCacheTTL == "" {
+		c.Backend.DocCacheTTL = DefaultDocCacheTTL.String()
+	}
+
 	if c.Mongo != nil {
 		if c.Mongo.ConnectionURI == "" {
 			c.Mongo.ConnectionURI = DefaultMongoConnectionURI
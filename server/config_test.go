@@ -98,5 +98,9 @@ func TestNewConfigFromFile(t *testing.T) {
 		projectCacheTTL, err := time.ParseDuration(conf.Backend.ProjectCacheTTL)
 		assert.NoError(t, err)
 		assert.Equal(t, projectCacheTTL, server.DefaultProjectCacheTTL)
+
+		docCacheTTL, err := time.ParseDuration(conf.Backend.DocCacheTTL)
+		assert.NoError(t, err)
+		assert.Equal(t, docCacheTTL, server.DefaultDocCacheTTL)
 	})
 }
@@ -19,14 +19,21 @@ package documents
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"strconv"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api/types"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
+	"github.com/yorkie-team/yorkie/pkg/document/operations"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/logging"
 	"github.com/yorkie-team/yorkie/server/packs"
 )
 
@@ -37,12 +44,28 @@ const SnapshotMaxLen = 50
 // pageSizeLimit is the limit of the pagination size of documents.
 const pageSizeLimit = 101
 
+// blamePageSize is the number of the changes loaded at once to blame the
+// document.
+const blamePageSize = 1000
+
 var (
 	// ErrDocumentAttached is returned when the document is attached when
 	// deleting the document.
 	ErrDocumentAttached = fmt.Errorf("document is attached")
+
+	// ErrRevisionPurged is returned when the changes needed to build the
+	// revision have already been purged.
+	ErrRevisionPurged = fmt.Errorf("revision purged")
+
+	// ErrDocumentAlreadyExists is returned when the document to fork into
+	// already exists.
+	ErrDocumentAlreadyExists = fmt.Errorf("document already exists")
 )
 
+// adminClientKeyPrefix is the prefix of the key of the client that the server
+// activates to update documents on behalf of admins.
+const adminClientKeyPrefix = "yorkie-admin"
+
 // ListDocumentSummaries returns a list of document summaries.
 func ListDocumentSummaries(
 	ctx context.Context,
@@ -187,6 +210,448 @@ func GetDocumentByServerSeq(
 	return doc, nil
 }
 
+// GetDocumentAtRevision returns a document at the given revision. If the
+// given time is not zero, the revision is the last change stored at or before
+// the time. Otherwise, the revision is the given server sequence.
+func GetDocumentAtRevision(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	serverSeq int64,
+	at gotime.Time,
+) (*document.InternalDocument, error) {
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
+		ctx,
+		types.ClientRefKey{
+			ProjectID: project.ID,
+			ClientID:  types.IDFromActorID(time.InitialActorID),
+		},
+		k,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	if !at.IsZero() {
+		info, err := be.DB.FindLatestChangeInfoBeforeTime(ctx, docInfo.RefKey(), at)
+		if err != nil && !errors.Is(err, database.ErrChangeNotFound) {
+			return nil, err
+		}
+
+		serverSeq = 0
+		if info != nil {
+			serverSeq = info.ServerSeq
+		}
+	}
+
+	return buildDocAtRevision(ctx, be, docInfo, serverSeq)
+}
+
+// buildDocAtRevision builds the document at the given server sequence. It
+// returns ErrRevisionPurged if the changes needed to build the revision have
+// been purged, instead of building the document from the partial changes.
+func buildDocAtRevision(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+) (*document.InternalDocument, error) {
+	if err := checkRevision(ctx, be, docInfo, serverSeq); err != nil {
+		return nil, err
+	}
+
+	return packs.BuildInternalDocForServerSeq(ctx, be, docInfo, serverSeq)
+}
+
+// checkRevision checks whether the document can be built at the given server
+// sequence. The revision should be able to be built from the closest snapshot
+// and the changes after it. If the changes have already been purged, it
+// returns ErrRevisionPurged.
+func checkRevision(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+) error {
+	if serverSeq < 0 || serverSeq > docInfo.ServerSeq {
+		return fmt.Errorf("%d: %w", serverSeq, packs.ErrInvalidServerSeq)
+	}
+
+	snapshotInfo, err := be.DB.FindClosestSnapshotInfo(ctx, docInfo.RefKey(), serverSeq, false)
+	if err != nil {
+		return err
+	}
+	if serverSeq == snapshotInfo.ServerSeq {
+		return nil
+	}
+
+	infos, err := be.DB.FindChangeInfosBetweenServerSeqs(
+		ctx,
+		docInfo.RefKey(),
+		snapshotInfo.ServerSeq+1,
+		serverSeq,
+	)
+	if err != nil {
+		return err
+	}
+	if int64(len(infos)) != serverSeq-snapshotInfo.ServerSeq {
+		return fmt.Errorf("%d: %w", serverSeq, ErrRevisionPurged)
+	}
+
+	return nil
+}
+
+// DiffDocument returns the differences of the document between the given two
+// revisions.
+func DiffDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	fromSeq int64,
+	toSeq int64,
+) ([]*crdt.Difference, error) {
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
+		ctx,
+		types.ClientRefKey{
+			ProjectID: project.ID,
+			ClientID:  types.IDFromActorID(time.InitialActorID),
+		},
+		k,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, serverSeq := range []int64{fromSeq, toSeq} {
+		if err := checkRevision(ctx, be, docInfo, serverSeq); err != nil {
+			return nil, err
+		}
+	}
+
+	from, err := packs.BuildInternalDocForServerSeq(ctx, be, docInfo, fromSeq)
+	if err != nil {
+		return nil, err
+	}
+	to, err := packs.BuildInternalDocForServerSeq(ctx, be, docInfo, toSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return crdt.Diff(from.Root(), to.Root())
+}
+
+// BlameDocument returns the attributions of the elements and the characters
+// of the document. The authors are taken from the tickets of the elements,
+// and the server sequences and the times of the writes are taken from the
+// change history.
+func BlameDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+) ([]*crdt.Attribution, error) {
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
+		ctx,
+		types.ClientRefKey{
+			ProjectID: project.ID,
+			ClientID:  types.IDFromActorID(time.InitialActorID),
+		},
+		k,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	doc, err := packs.BuildInternalDocForServerSeq(ctx, be, docInfo, docInfo.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+	attrs := crdt.Blame(doc.Root())
+
+	// NOTE: Only the changes that wrote the attributions are kept, and the
+	// changes are loaded page by page to bound the memory usage.
+	changes := make(map[string]*database.ChangeInfo)
+	for _, attr := range attrs {
+		changes[changeKeyOf(attr.Author, attr.Lamport)] = nil
+	}
+
+	// NOTE: The value of a counter is written by the increases after its
+	// creation, so the last increase is the last write of the counter.
+	increases := make(map[string]*database.ChangeInfo)
+	for from := int64(1); from <= docInfo.ServerSeq; from += blamePageSize {
+		to := min(from+blamePageSize-1, docInfo.ServerSeq)
+		infos, err := be.DB.FindChangeInfosBetweenServerSeqs(ctx, docInfo.RefKey(), from, to)
+		if err != nil {
+			return nil, err
+		}
+
+		for _, info := range infos {
+			key := changeKeyOf(info.ActorID.String(), info.Lamport)
+			if _, ok := changes[key]; ok {
+				changes[key] = info
+			}
+
+			c, err := info.ToChange()
+			if err != nil {
+				return nil, err
+			}
+			for _, op := range c.Operations() {
+				if _, ok := op.(*operations.Increase); !ok {
+					continue
+				}
+				path, err := doc.Root().CreatePath(op.ParentCreatedAt())
+				if err != nil {
+					continue
+				}
+				increases[path] = info
+			}
+		}
+	}
+
+	for _, attr := range attrs {
+		if info, ok := increases[attr.Path]; ok && attr.Content == "" {
+			attr.Author = info.ActorID.String()
+			attr.Lamport = info.Lamport
+			attr.ServerSeq = info.ServerSeq
+			attr.UpdatedAt = info.CreatedAt
+			continue
+		}
+		if info := changes[changeKeyOf(attr.Author, attr.Lamport)]; info != nil {
+			attr.ServerSeq = info.ServerSeq
+			attr.UpdatedAt = info.CreatedAt
+		}
+	}
+
+	return attrs, nil
+}
+
+// changeKeyOf returns the key of the change made by the given actor at the
+// given lamport timestamp.
+func changeKeyOf(actorID string, lamport int64) string {
+	return actorID + ":" + strconv.FormatInt(lamport, 10)
+}
+
+// CreateRevisionTag creates a tag of the given name that points to the given
+// server sequence of the document. The caller should hold the snapshot lock of
+// the document so that the changes of the revision are not purged meanwhile.
+func CreateRevisionTag(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	name string,
+	serverSeq int64,
+	message string,
+	author string,
+) (*types.RevisionTag, error) {
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
+		ctx,
+		types.ClientRefKey{
+			ProjectID: project.ID,
+			ClientID:  types.IDFromActorID(time.InitialActorID),
+		},
+		k,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: If the changes of the revision have already been purged, the
+	// revision cannot be tagged.
+	if err := checkRevision(ctx, be, docInfo, serverSeq); err != nil {
+		return nil, err
+	}
+
+	info, err := be.DB.CreateRevisionTagInfo(ctx, docInfo.RefKey(), name, serverSeq, message, author)
+	if err != nil {
+		return nil, err
+	}
+
+	return info.ToRevisionTag(), nil
+}
+
+// ListRevisionTags returns the tags of the document in the order of the
+// server sequence.
+func ListRevisionTags(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+) ([]*types.RevisionTag, error) {
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
+		ctx,
+		types.ClientRefKey{
+			ProjectID: project.ID,
+			ClientID:  types.IDFromActorID(time.InitialActorID),
+		},
+		k,
+		false,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	infos, err := be.DB.FindRevisionTagInfos(ctx, docInfo.RefKey())
+	if err != nil {
+		return nil, err
+	}
+
+	var tags []*types.RevisionTag
+	for _, info := range infos {
+		tags = append(tags, info.ToRevisionTag())
+	}
+
+	return tags, nil
+}
+
+// DeleteRevisionTag deletes the tag of the given name of the document.
+func DeleteRevisionTag(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	name string,
+) error {
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(
+		ctx,
+		types.ClientRefKey{
+			ProjectID: project.ID,
+			ClientID:  types.IDFromActorID(time.InitialActorID),
+		},
+		k,
+		false,
+	)
+	if err != nil {
+		return err
+	}
+
+	return be.DB.DeleteRevisionTagInfo(ctx, docInfo.RefKey(), name)
+}
+
+// ForkDocument creates a new document of the given target key in the target
+// project with the root of the document at the given server sequence.
+func ForkDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	serverSeq int64,
+	targetProject *types.Project,
+	targetKey key.Key,
+) (*types.DocumentSummary, error) {
+	revision, err := GetDocumentAtRevision(ctx, be, project, k, serverSeq, gotime.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	if _, err := be.DB.FindDocInfoByKey(ctx, targetProject.ID, targetKey); err == nil {
+		return nil, fmt.Errorf("%s: %w", targetKey, ErrDocumentAlreadyExists)
+	} else if !errors.Is(err, database.ErrDocumentNotFound) {
+		return nil, err
+	}
+
+	if err := updateByAdmin(ctx, be, targetProject, targetKey, func(doc *document.Document) error {
+		return doc.Restore(revision.Root(), fmt.Sprintf("fork %s at %d", k, serverSeq))
+	}); err != nil {
+		return nil, err
+	}
+
+	return GetDocumentSummary(ctx, be, targetProject, targetKey)
+}
+
+// RestoreDocument appends a change that restores the root of the document to
+// the root at the given server sequence. The clients attached to the document
+// converge to the restored root by applying the change.
+func RestoreDocument(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	serverSeq int64,
+) (*types.DocumentSummary, error) {
+	revision, err := GetDocumentAtRevision(ctx, be, project, k, serverSeq, gotime.Time{})
+	if err != nil {
+		return nil, err
+	}
+
+	if err := updateByAdmin(ctx, be, project, k, func(doc *document.Document) error {
+		return doc.Restore(revision.Root(), fmt.Sprintf("restore to %d", serverSeq))
+	}); err != nil {
+		return nil, err
+	}
+
+	return GetDocumentSummary(ctx, be, project, k)
+}
+
+// updateByAdmin updates the document of the given key with the given updater
+// as a client of the server. The client is attached to the document only
+// while pushing the change, so it does not hold back the garbage collection
+// of the document. The caller should hold the lock of the document.
+func updateByAdmin(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	k key.Key,
+	updater func(doc *document.Document) error,
+) error {
+	clientInfo, err := be.DB.ActivateClient(
+		ctx,
+		project.ID,
+		fmt.Sprintf("%s-%s", adminClientKeyPrefix, k),
+		nil,
+	)
+	if err != nil {
+		return err
+	}
+	defer func() {
+		if _, err := be.DB.DeactivateClient(ctx, clientInfo.RefKey()); err != nil {
+			logging.From(ctx).Error(err)
+		}
+	}()
+
+	actorID, err := clientInfo.ID.ToActorID()
+	if err != nil {
+		return err
+	}
+
+	docInfo, err := be.DB.FindDocInfoByKeyAndOwner(ctx, clientInfo.RefKey(), k, true)
+	if err != nil {
+		return err
+	}
+	if err := clientInfo.AttachDocument(docInfo.ID, false); err != nil {
+		return err
+	}
+
+	doc, err := packs.BuildDocForCheckpoint(
+		ctx,
+		be,
+		docInfo,
+		change.InitialCheckpoint.NextServerSeq(docInfo.ServerSeq),
+		actorID,
+	)
+	if err != nil {
+		return err
+	}
+	if err := updater(doc); err != nil {
+		return err
+	}
+
+	if _, err := packs.PushPull(ctx, be, project, clientInfo, docInfo, doc.CreateChangePack(), packs.PushPullOptions{
+		Mode:   types.SyncModePushPull,
+		Status: document.StatusDetached,
+	}); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 // SearchDocumentSummaries returns document summaries that match the query parameters.
 func SearchDocumentSummaries(
 	ctx context.Context,
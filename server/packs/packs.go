@@ -32,6 +32,7 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	pkgtypes "github.com/yorkie-team/yorkie/pkg/types"
 	"github.com/yorkie-team/yorkie/pkg/units"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
@@ -57,6 +58,10 @@ type PushPullOptions struct {
 
 	// Status represents the status of the document to be updated.
 	Status document.StatusType
+
+	// Rules represents the path-scoped rules of the client. The pushed
+	// changes that write to the paths not allowed by them are rejected.
+	Rules []types.PathRule
 }
 
 // PushPull stores the given changes and returns accumulated changes of the
@@ -88,7 +93,23 @@ func PushPull(
 	be.Metrics.AddPushPullReceivedChanges(hostname, project, reqPack.ChangesLen())
 	be.Metrics.AddPushPullReceivedOperations(hostname, project, reqPack.OperationsLen())
 
-	// 02. pull pack: pull changes or a snapshot from the database and create a response pack.
+	// 02. validate pushed changes: check the pushed changes do not write to
+	// the paths not allowed by the rules, and the root after applying them
+	// conforms to the document schema of the project.
+	validatedDoc, err := validateChanges(
+		ctx,
+		be,
+		project,
+		docInfo,
+		pushedChanges,
+		initialServerSeq,
+		opts.Rules,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	// 03. pull pack: pull changes or a snapshot from the database and create a response pack.
 	respPack, err := pullPack(ctx, be, clientInfo, docInfo, reqPack, cpAfterPush, initialServerSeq, opts.Mode)
 	if err != nil {
 		return nil, err
@@ -97,7 +118,7 @@ func PushPull(
 	be.Metrics.AddPushPullSentOperations(hostname, project, respPack.OperationsLen())
 	be.Metrics.AddPushPullSnapshotBytes(hostname, project, respPack.SnapshotLen())
 
-	// 03. update the client's document and checkpoint.
+	// 04. update the client's document and checkpoint.
 	docRefKey := docInfo.RefKey()
 	if opts.Status == document.StatusRemoved {
 		if err := clientInfo.RemoveDocument(docInfo.ID); err != nil {
@@ -113,7 +134,7 @@ func PushPull(
 		}
 	}
 
-	// 04. store pushed changes, docInfo and checkpoint of the client to DB.
+	// 05. store pushed changes, docInfo and checkpoint of the client to DB.
 	if len(pushedChanges) > 0 || reqPack.IsRemoved {
 		if err := be.DB.CreateChangeInfos(
 			ctx,
@@ -127,11 +148,24 @@ func PushPull(
 		}
 	}
 
+	// NOTE: Cache the validated document after the changes are
+	// stored, so that the next push can be validated without rebuilding it.
+	if validatedDoc != nil && !reqPack.IsRemoved {
+		be.DocCache.Add(
+			docRefKey,
+			pkgtypes.Pair[int64, *document.InternalDocument]{
+				First:  docInfo.ServerSeq,
+				Second: validatedDoc,
+			},
+			be.Config.ParseDocCacheTTL(),
+		)
+	}
+
 	if err := be.DB.UpdateClientInfoAfterPushPull(ctx, clientInfo, docInfo); err != nil {
 		return nil, err
 	}
 
-	// 05. update and find min synced version vector for garbage collection.
+	// 06. update and find min synced version vector for garbage collection.
 	// NOTE(hackerwins): Since the client could not receive the response, the
 	// requested seq(reqPack) is stored instead of the response seq(resPack).
 	minSyncedVersionVector, err := be.DB.UpdateAndFindMinSyncedVersionVector(
@@ -177,7 +211,7 @@ func PushPull(
 		gotime.Since(start),
 	)
 
-	// 06. publish document change event then store snapshot asynchronously.
+	// 07. publish document change event then store snapshot asynchronously.
 	if len(pushedChanges) > 0 || reqPack.IsRemoved {
 		be.Background.AttachGoroutine(func(ctx context.Context) {
 			publisherID, err := clientInfo.ID.ToActorID()
@@ -200,6 +234,17 @@ func PushPull(
 			)
 
 			if reqPack.OperationsLen() > 0 {
+				if err := producePatches(
+					ctx,
+					be,
+					project,
+					docInfo,
+					pushedChanges,
+					initialServerSeq,
+				); err != nil {
+					logging.From(ctx).Error(err)
+				}
+
 				if err := webhook.SendEvent(
 					ctx,
 					be,
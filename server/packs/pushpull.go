@@ -20,12 +20,16 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	gotime "time"
 
 	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
+	"github.com/yorkie-team/yorkie/pkg/schema"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/backend/database"
+	"github.com/yorkie-team/yorkie/server/backend/messagebroker"
 	"github.com/yorkie-team/yorkie/server/logging"
 )
 
@@ -33,6 +37,10 @@ var (
 	// ErrInvalidServerSeq is returned when the given server seq greater than
 	// the initial server seq.
 	ErrInvalidServerSeq = errors.New("invalid server seq")
+
+	// ErrPathNotWritable is returned when the pushed changes write to the path
+	// that is not allowed by the path-scoped rules of the client.
+	ErrPathNotWritable = errors.New("path is not writable for this user")
 )
 
 // pushChanges returns the changes excluding already saved in DB.
@@ -79,6 +87,196 @@ func pushChanges(
 	return cp, pushedChanges
 }
 
+// validateChanges validates the pushed changes with the given path-scoped
+// rules and the document schema of the project. It returns an error wrapping
+// ErrPathNotWritable if an operation writes to the path not allowed by the
+// rules, or wrapping schema.ErrSchemaViolation if the root after applying the
+// changes violates the schema. If the changes are valid, it returns the
+// document that the changes are applied to, or nil if nothing is validated.
+//
+// NOTE: Only the changes that have operations are validated, because the
+// changes without operations, such as the presence changes of attaching or
+// detaching, do not modify the root. So a new document is validated once the
+// first change with operations is pushed, and the document that does not
+// conform to the schema added later can still be detached.
+func validateChanges(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	pushedChanges []*change.Change,
+	initialServerSeq int64,
+	rules []types.PathRule,
+) (*document.InternalDocument, error) {
+	if (project.DocumentSchema == "" && len(rules) == 0) || !hasOperations(pushedChanges) {
+		return nil, nil
+	}
+
+	var rule *schema.Rule
+	if project.DocumentSchema != "" {
+		parsed, err := schema.Parse(project.DocumentSchema)
+		if err != nil {
+			return nil, err
+		}
+		rule = parsed
+	}
+
+	doc, err := takeDocForServerSeq(ctx, be, docInfo, initialServerSeq)
+	if err != nil {
+		return nil, err
+	}
+	events, err := doc.ApplyChanges(pushedChanges...)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(rules) > 0 {
+		if err := checkWritable(events, rules); err != nil {
+			return nil, err
+		}
+	}
+
+	if rule != nil {
+		if err := rule.Validate(doc.RootObject()); err != nil {
+			return nil, err
+		}
+	}
+
+	return doc, nil
+}
+
+// checkWritable checks whether the operations of the pushed changes write to
+// the paths allowed by the given rules. The events are the result of applying
+// the changes.
+//
+// NOTE: The operations that have no effect, such as removing the element
+// already removed by a concurrent change, are not in the events. They are
+// allowed because they write nothing to the document.
+func checkWritable(events []document.DocEvent, rules []types.PathRule) error {
+	for _, e := range events {
+		if e.Type != document.RemoteChangeEvent {
+			continue
+		}
+
+		for _, op := range e.Change.Operations {
+			subPaths := op.ElementSubPaths()
+			if subPaths == nil || !types.IsWritable(rules, subPaths) {
+				return fmt.Errorf("%s: %w", op.ElementPath(), ErrPathNotWritable)
+			}
+		}
+	}
+
+	return nil
+}
+
+// hasOperations returns whether any of the given changes has operations.
+func hasOperations(changes []*change.Change) bool {
+	for _, c := range changes {
+		if len(c.Operations()) > 0 {
+			return true
+		}
+	}
+	return false
+}
+
+// takeDocForServerSeq returns the document built up to the given serverSeq.
+// It takes the document validated by the previous push out of the cache, and
+// applies the changes stored after it, e.g. the changes pushed without
+// validation, so that the document is not rebuilt from the snapshot for
+// every push. If the document is not cached or is too old, it builds a new
+// one.
+//
+// NOTE: The document is removed from the cache because it is
+// modified while validating the changes. It is put back only after the
+// changes are stored, and the caller should hold the PushPull lock.
+func takeDocForServerSeq(
+	ctx context.Context,
+	be *backend.Backend,
+	docInfo *database.DocInfo,
+	serverSeq int64,
+) (*document.InternalDocument, error) {
+	docRefKey := docInfo.RefKey()
+	cached, ok := be.DocCache.Get(docRefKey)
+	if !ok {
+		return BuildInternalDocForServerSeq(ctx, be, docInfo, serverSeq)
+	}
+
+	be.DocCache.Remove(docRefKey)
+	if cached.First == serverSeq {
+		return cached.Second, nil
+	}
+	if cached.First > serverSeq || serverSeq-cached.First > be.Config.SnapshotThreshold {
+		return BuildInternalDocForServerSeq(ctx, be, docInfo, serverSeq)
+	}
+
+	changes, err := be.DB.FindChangesBetweenServerSeqs(ctx, docRefKey, cached.First+1, serverSeq)
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(changes)) != serverSeq-cached.First {
+		return BuildInternalDocForServerSeq(ctx, be, docInfo, serverSeq)
+	}
+
+	doc := cached.Second
+	if err := doc.ApplyChangePack(change.NewPack(
+		docInfo.Key,
+		change.InitialCheckpoint.NextServerSeq(serverSeq),
+		changes,
+		nil,
+		nil,
+	), be.Config.SnapshotDisableGC); err != nil {
+		return nil, err
+	}
+
+	return doc, nil
+}
+
+// producePatches produces the JSON Patch of each pushed change to the message
+// broker. The patches are built by applying the pushed changes to the document
+// of the initial server seq.
+func producePatches(
+	ctx context.Context,
+	be *backend.Backend,
+	project *types.Project,
+	docInfo *database.DocInfo,
+	pushedChanges []*change.Change,
+	initialServerSeq int64,
+) error {
+	// NOTE: Building the document is expensive, so we skip it if the message
+	// broker is not configured.
+	if _, ok := be.MsgBroker.(*messagebroker.DummyBroker); ok || len(pushedChanges) == 0 {
+		return nil
+	}
+
+	doc, err := BuildInternalDocForServerSeq(ctx, be, docInfo, initialServerSeq)
+	if err != nil {
+		return err
+	}
+	events, err := doc.ApplyChangesWithPatches(pushedChanges...)
+	if err != nil {
+		return err
+	}
+
+	for _, e := range events {
+		if e.Type != document.RemoteChangeEvent || len(e.Change.Patches) == 0 {
+			continue
+		}
+
+		if err := be.MsgBroker.Produce(ctx, messagebroker.DocumentPatchMessage{
+			ProjectID:   project.ID.String(),
+			DocumentKey: docInfo.Key.String(),
+			ActorID:     e.Change.ActorID.String(),
+			ServerSeq:   e.Change.ServerSeq,
+			Patches:     e.Change.Patches,
+			Timestamp:   gotime.Now(),
+		}); err != nil {
+			return err
+		}
+	}
+
+	return nil
+}
+
 func pullPack(
 	ctx context.Context,
 	be *backend.Backend,
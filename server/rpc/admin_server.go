@@ -20,6 +20,7 @@ import (
 	"context"
 	"fmt"
 	"runtime"
+	gotime "time"
 
 	"connectrpc.com/connect"
 
@@ -30,6 +31,7 @@ import (
 	"github.com/yorkie-team/yorkie/internal/version"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/schema"
 	"github.com/yorkie-team/yorkie/server/backend"
 	"github.com/yorkie-team/yorkie/server/documents"
 	"github.com/yorkie-team/yorkie/server/logging"
@@ -213,6 +215,11 @@ func (s *adminServer) UpdateProject(
 	if err = fields.Validate(); err != nil {
 		return nil, err
 	}
+	if fields.DocumentSchema != nil && *fields.DocumentSchema != "" {
+		if _, err = schema.Parse(*fields.DocumentSchema); err != nil {
+			return nil, err
+		}
+	}
 
 	user := users.From(ctx)
 	project, err := projects.UpdateProject(
@@ -328,6 +335,283 @@ func (s *adminServer) GetSnapshotMeta(
 	}), nil
 }
 
+// GetDocumentAtRevision gets the document at the given revision.
+func (s *adminServer) GetDocumentAtRevision(
+	ctx context.Context,
+	req *connect.Request[api.GetDocumentAtRevisionRequest],
+) (*connect.Response[api.GetDocumentAtRevisionResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	var at gotime.Time
+	if req.Msg.Timestamp != nil {
+		at = req.Msg.Timestamp.AsTime()
+	}
+
+	doc, err := documents.GetDocumentAtRevision(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+		req.Msg.ServerSeq,
+		at,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.GetDocumentAtRevisionResponse{
+		ServerSeq: doc.Checkpoint().ServerSeq,
+		Root:      doc.Marshal(),
+		Presences: converter.ToPresences(doc.AllPresences()),
+	}), nil
+}
+
+// DiffDocument returns the differences of the document between two revisions.
+func (s *adminServer) DiffDocument(
+	ctx context.Context,
+	req *connect.Request[api.DiffDocumentRequest],
+) (*connect.Response[api.DiffDocumentResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	diffs, err := documents.DiffDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+		req.Msg.FromSeq,
+		req.Msg.ToSeq,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.DiffDocumentResponse{
+		Differences: converter.ToDocumentDiffs(diffs),
+	}), nil
+}
+
+// CreateRevisionTag creates a tag that names a revision of the document.
+func (s *adminServer) CreateRevisionTag(
+	ctx context.Context,
+	req *connect.Request[api.CreateRevisionTagRequest],
+) (*connect.Response[api.CreateRevisionTagResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	// NOTE: Stale changes are purged while holding the snapshot lock, so the
+	// lock is held until the tag is stored to keep the changes of the
+	// revision from being purged after they are checked.
+	docKey := key.Key(req.Msg.DocumentKey)
+	locker, err := s.backend.Locker.NewLocker(ctx, packs.SnapshotKey(project.ID, docKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}()
+
+	tag, err := documents.CreateRevisionTag(
+		ctx,
+		s.backend,
+		project,
+		docKey,
+		req.Msg.Name,
+		req.Msg.ServerSeq,
+		req.Msg.Message,
+		user.Username,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.CreateRevisionTagResponse{
+		Tag: converter.ToRevisionTag(tag),
+	}), nil
+}
+
+// ListRevisionTags lists the tags of the document.
+func (s *adminServer) ListRevisionTags(
+	ctx context.Context,
+	req *connect.Request[api.ListRevisionTagsRequest],
+) (*connect.Response[api.ListRevisionTagsResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	tags, err := documents.ListRevisionTags(ctx, s.backend, project, key.Key(req.Msg.DocumentKey))
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.ListRevisionTagsResponse{
+		Tags: converter.ToRevisionTags(tags),
+	}), nil
+}
+
+// DeleteRevisionTag deletes the tag of the document.
+func (s *adminServer) DeleteRevisionTag(
+	ctx context.Context,
+	req *connect.Request[api.DeleteRevisionTagRequest],
+) (*connect.Response[api.DeleteRevisionTagResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := documents.DeleteRevisionTag(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+		req.Msg.Name,
+	); err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.DeleteRevisionTagResponse{}), nil
+}
+
+// ForkDocument creates a new document with the root of the document at the
+// given revision.
+func (s *adminServer) ForkDocument(
+	ctx context.Context,
+	req *connect.Request[api.ForkDocumentRequest],
+) (*connect.Response[api.ForkDocumentResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	targetProject := project
+	if req.Msg.TargetProjectName != "" {
+		targetProject, err = projects.GetProject(ctx, s.backend, user.ID, req.Msg.TargetProjectName)
+		if err != nil {
+			return nil, err
+		}
+	}
+
+	targetKey := key.Key(req.Msg.TargetDocumentKey)
+	if err := targetKey.Validate(); err != nil {
+		return nil, err
+	}
+
+	locker, err := s.backend.Locker.NewLocker(ctx, packs.PushPullKey(targetProject.ID, targetKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}()
+
+	summary, err := documents.ForkDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+		req.Msg.ServerSeq,
+		targetProject,
+		targetKey,
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.ForkDocumentResponse{
+		Document: converter.ToDocumentSummary(summary),
+	}), nil
+}
+
+// RestoreDocument restores the document to the given revision by appending a
+// change made by the server.
+func (s *adminServer) RestoreDocument(
+	ctx context.Context,
+	req *connect.Request[api.RestoreDocumentRequest],
+) (*connect.Response[api.RestoreDocumentResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	docKey := key.Key(req.Msg.DocumentKey)
+	locker, err := s.backend.Locker.NewLocker(ctx, packs.PushPullKey(project.ID, docKey))
+	if err != nil {
+		return nil, err
+	}
+
+	if err := locker.Lock(ctx); err != nil {
+		return nil, err
+	}
+	defer func() {
+		if err := locker.Unlock(ctx); err != nil {
+			logging.DefaultLogger().Error(err)
+		}
+	}()
+
+	summary, err := documents.RestoreDocument(ctx, s.backend, project, docKey, req.Msg.ServerSeq)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.RestoreDocumentResponse{
+		Document: converter.ToDocumentSummary(summary),
+	}), nil
+}
+
+// BlameDocument returns the attributions of the elements and the characters
+// of the document.
+func (s *adminServer) BlameDocument(
+	ctx context.Context,
+	req *connect.Request[api.BlameDocumentRequest],
+) (*connect.Response[api.BlameDocumentResponse], error) {
+	user := users.From(ctx)
+	project, err := projects.GetProject(ctx, s.backend, user.ID, req.Msg.ProjectName)
+	if err != nil {
+		return nil, err
+	}
+
+	attrs, err := documents.BlameDocument(
+		ctx,
+		s.backend,
+		project,
+		key.Key(req.Msg.DocumentKey),
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return connect.NewResponse(&api.BlameDocumentResponse{
+		Attributions: converter.ToDocumentAttributions(attrs),
+	}), nil
+}
+
 // ListDocuments lists documents.
 func (s *adminServer) ListDocuments(
 	ctx context.Context,
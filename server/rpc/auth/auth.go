@@ -42,7 +42,8 @@ func AccessAttributes(pack *change.Pack) []types.AccessAttribute {
 	}}
 }
 
-// VerifyAccess verifies the given access.
+// VerifyAccess verifies the given access. The path-scoped rules given by the
+// webhook are set to the attributes of the given access info.
 func VerifyAccess(ctx context.Context, be *backend.Backend, accessInfo *types.AccessInfo) error {
 	md := metadata.From(ctx)
 	prj := projects.From(ctx)
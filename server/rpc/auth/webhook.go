@@ -59,7 +59,11 @@ func verifyAccess(
 
 	cacheKey := generateCacheKey(prj.PublicKey, body)
 	if entry, ok := be.AuthWebhookCache.Get(cacheKey); ok {
-		return handleWebhookResponse(entry.First, entry.Second)
+		if err := handleWebhookResponse(entry.First, entry.Second); err != nil {
+			return err
+		}
+		accessInfo.SetRules(entry.Second.Attributes)
+		return nil
 	}
 
 	res, status, err := be.AuthWebhookClient.Send(
@@ -81,7 +85,11 @@ func verifyAccess(
 		)
 	}
 
-	return handleWebhookResponse(status, res)
+	if err := handleWebhookResponse(status, res); err != nil {
+		return err
+	}
+	accessInfo.SetRules(res.Attributes)
+	return nil
 }
 
 // generateCacheKey creates a unique key for caching webhook responses.
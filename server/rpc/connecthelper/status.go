@@ -30,6 +30,7 @@ import (
 	"github.com/yorkie-team/yorkie/internal/validation"
 	"github.com/yorkie-team/yorkie/pkg/document/key"
 	"github.com/yorkie-team/yorkie/pkg/document/time"
+	"github.com/yorkie-team/yorkie/pkg/schema"
 	"github.com/yorkie-team/yorkie/pkg/webhook"
 	"github.com/yorkie-team/yorkie/server/backend/database"
 	"github.com/yorkie-team/yorkie/server/clients"
@@ -50,17 +51,21 @@ var errorToConnectCode = map[error]connect.Code{
 	clients.ErrInvalidClientKey:     connect.CodeInvalidArgument,
 	key.ErrInvalidKey:               connect.CodeInvalidArgument,
 	types.ErrEmptyProjectFields:     connect.CodeInvalidArgument,
+	schema.ErrInvalidSchema:         connect.CodeInvalidArgument,
 
 	// NotFound means the requested resource does not exist.
-	database.ErrProjectNotFound:  connect.CodeNotFound,
-	database.ErrClientNotFound:   connect.CodeNotFound,
-	database.ErrDocumentNotFound: connect.CodeNotFound,
-	database.ErrUserNotFound:     connect.CodeNotFound,
+	database.ErrProjectNotFound:     connect.CodeNotFound,
+	database.ErrClientNotFound:      connect.CodeNotFound,
+	database.ErrDocumentNotFound:    connect.CodeNotFound,
+	database.ErrUserNotFound:        connect.CodeNotFound,
+	database.ErrRevisionTagNotFound: connect.CodeNotFound,
 
 	// AlreadyExists means the requested resource already exists.
 	database.ErrProjectAlreadyExists:     connect.CodeAlreadyExists,
 	database.ErrProjectNameAlreadyExists: connect.CodeAlreadyExists,
 	database.ErrUserAlreadyExists:        connect.CodeAlreadyExists,
+	database.ErrRevisionTagAlreadyExists: connect.CodeAlreadyExists,
+	documents.ErrDocumentAlreadyExists:   connect.CodeAlreadyExists,
 
 	// FailedPrecondition means the request is rejected because the state of the
 	// system is not the desired state.
@@ -70,6 +75,8 @@ var errorToConnectCode = map[error]connect.Code{
 	database.ErrDocumentAlreadyDetached: connect.CodeFailedPrecondition,
 	documents.ErrDocumentAttached:       connect.CodeFailedPrecondition,
 	packs.ErrInvalidServerSeq:           connect.CodeFailedPrecondition,
+	documents.ErrRevisionPurged:         connect.CodeFailedPrecondition,
+	schema.ErrSchemaViolation:           connect.CodeFailedPrecondition,
 	database.ErrConflictOnUpdate:        connect.CodeFailedPrecondition,
 
 	// Unimplemented means the server does not implement the functionality.
@@ -90,6 +97,7 @@ var errorToConnectCode = map[error]connect.Code{
 
 	// PermissionDenied means the request does not have permission for the operation.
 	auth.ErrPermissionDenied: connect.CodePermissionDenied,
+	packs.ErrPathNotWritable: connect.CodePermissionDenied,
 
 	// Canceled means the operation was canceled (typically by the caller).
 	context.Canceled: connect.CodeCanceled,
@@ -108,15 +116,19 @@ var errorToCode = map[error]string{
 	clients.ErrInvalidClientKey:     "ErrInvalidClientKey",
 	key.ErrInvalidKey:               "ErrInvalidKey",
 	types.ErrEmptyProjectFields:     "ErrEmptyProjectFields",
+	schema.ErrInvalidSchema:         "ErrInvalidSchema",
 
-	database.ErrProjectNotFound:  "ErrProjectNotFound",
-	database.ErrClientNotFound:   "ErrClientNotFound",
-	database.ErrDocumentNotFound: "ErrDocumentNotFound",
-	database.ErrUserNotFound:     "ErrUserNotFound",
+	database.ErrProjectNotFound:     "ErrProjectNotFound",
+	database.ErrClientNotFound:      "ErrClientNotFound",
+	database.ErrDocumentNotFound:    "ErrDocumentNotFound",
+	database.ErrUserNotFound:        "ErrUserNotFound",
+	database.ErrRevisionTagNotFound: "ErrRevisionTagNotFound",
 
 	database.ErrProjectAlreadyExists:     "ErrProjectAlreadyExists",
 	database.ErrProjectNameAlreadyExists: "ErrProjectNameAlreadyExists",
 	database.ErrUserAlreadyExists:        "ErrUserAlreadyExists",
+	database.ErrRevisionTagAlreadyExists: "ErrRevisionTagAlreadyExists",
+	documents.ErrDocumentAlreadyExists:   "ErrDocumentAlreadyExists",
 
 	database.ErrClientNotActivated:      "ErrClientNotActivated",
 	database.ErrDocumentNotAttached:     "ErrDocumentNotAttached",
@@ -124,6 +136,8 @@ var errorToCode = map[error]string{
 	database.ErrDocumentAlreadyDetached: "ErrDocumentAlreadyDetached",
 	documents.ErrDocumentAttached:       "ErrDocumentAttached",
 	packs.ErrInvalidServerSeq:           "ErrInvalidServerSeq",
+	documents.ErrRevisionPurged:         "ErrRevisionPurged",
+	schema.ErrSchemaViolation:           "ErrSchemaViolation",
 	database.ErrConflictOnUpdate:        "ErrConflictOnUpdate",
 
 	converter.ErrUnsupportedOperation:   "ErrUnsupportedOperation",
@@ -133,6 +147,7 @@ var errorToCode = map[error]string{
 	converter.ErrUnsupportedCounterType: "ErrUnsupportedCounterType",
 
 	auth.ErrPermissionDenied:        "ErrPermissionDenied",
+	packs.ErrPathNotWritable:        "ErrPathNotWritable",
 	auth.ErrUnauthenticated:         "ErrUnauthenticated",
 	webhook.ErrUnexpectedResponse:   "ErrUnexpectedResponse",
 	webhook.ErrUnexpectedStatusCode: "ErrUnexpectedStatusCode",
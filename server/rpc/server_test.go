@@ -83,6 +83,8 @@ func TestMain(m *testing.M) {
 		EventWebhookRequestTimeout:  helper.EventWebhookRequestTimeout.String(),
 		ProjectCacheSize:            helper.ProjectCacheSize,
 		ProjectCacheTTL:             helper.ProjectCacheTTL.String(),
+		DocCacheSize:                helper.DocCacheSize,
+		DocCacheTTL:                 helper.DocCacheTTL.String(),
 		AdminTokenDuration:          helper.AdminTokenDuration,
 	}, &mongo.Config{
 		ConnectionURI:     helper.MongoConnectionURI,
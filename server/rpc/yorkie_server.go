@@ -142,10 +142,11 @@ func (s *yorkieServer) AttachDocument(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	accessInfo := &types.AccessInfo{
 		Method:     types.AttachDocument,
 		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
+	}
+	if err := auth.VerifyAccess(ctx, s.backend, accessInfo); err != nil {
 		return nil, err
 	}
 
@@ -183,6 +184,7 @@ func (s *yorkieServer) AttachDocument(
 	pulled, err := packs.PushPull(ctx, s.backend, project, clientInfo, docInfo, pack, packs.PushPullOptions{
 		Mode:   types.SyncModePushPull,
 		Status: document.StatusAttached,
+		Rules:  accessInfo.RulesOf(pack.DocumentKey),
 	})
 	if err != nil {
 		return nil, err
@@ -218,10 +220,11 @@ func (s *yorkieServer) DetachDocument(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	accessInfo := &types.AccessInfo{
 		Method:     types.DetachDocument,
 		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
+	}
+	if err := auth.VerifyAccess(ctx, s.backend, accessInfo); err != nil {
 		return nil, err
 	}
 
@@ -277,6 +280,7 @@ func (s *yorkieServer) DetachDocument(
 	pulled, err := packs.PushPull(ctx, s.backend, project, clientInfo, docInfo, pack, packs.PushPullOptions{
 		Mode:   types.SyncModePushPull,
 		Status: status,
+		Rules:  accessInfo.RulesOf(pack.DocumentKey),
 	})
 	if err != nil {
 		return nil, err
@@ -312,10 +316,11 @@ func (s *yorkieServer) PushPullChanges(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	accessInfo := &types.AccessInfo{
 		Method:     types.PushPull,
 		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
+	}
+	if err := auth.VerifyAccess(ctx, s.backend, accessInfo); err != nil {
 		return nil, err
 	}
 
@@ -369,6 +374,7 @@ func (s *yorkieServer) PushPullChanges(
 	pulled, err := packs.PushPull(ctx, s.backend, project, clientInfo, docInfo, pack, packs.PushPullOptions{
 		Mode:   syncMode,
 		Status: document.StatusAttached,
+		Rules:  accessInfo.RulesOf(pack.DocumentKey),
 	})
 	if err != nil {
 		return nil, err
@@ -529,10 +535,11 @@ func (s *yorkieServer) RemoveDocument(
 		return nil, err
 	}
 
-	if err := auth.VerifyAccess(ctx, s.backend, &types.AccessInfo{
+	accessInfo := &types.AccessInfo{
 		Method:     types.RemoveDocument,
 		Attributes: auth.AccessAttributes(pack),
-	}); err != nil {
+	}
+	if err := auth.VerifyAccess(ctx, s.backend, accessInfo); err != nil {
 		return nil, err
 	}
 
@@ -574,6 +581,7 @@ func (s *yorkieServer) RemoveDocument(
 	pulled, err := packs.PushPull(ctx, s.backend, project, clientInfo, docInfo, pack, packs.PushPullOptions{
 		Mode:   types.SyncModePushPull,
 		Status: document.StatusRemoved,
+		Rules:  accessInfo.RulesOf(pack.DocumentKey),
 	})
 	if err != nil {
 		return nil, err
@@ -88,6 +88,8 @@ func TestMain(m *testing.M) {
 		EventWebhookRequestTimeout:  helper.EventWebhookRequestTimeout.String(),
 		ProjectCacheSize:            helper.ProjectCacheSize,
 		ProjectCacheTTL:             helper.ProjectCacheTTL.String(),
+		DocCacheSize:                helper.DocCacheSize,
+		DocCacheTTL:                 helper.DocCacheTTL.String(),
 		AdminTokenDuration:          helper.AdminTokenDuration,
 		GatewayAddr:                 fmt.Sprintf("localhost:%d", helper.RPCPort),
 	}, &mongo.Config{
@@ -154,6 +154,11 @@ type editOperationType struct {
 	desc       string
 }
 
+type moveOperationType struct {
+	fromPath, toPath []int
+	desc             string
+}
+
 func (op styleOperationType) getDesc() string {
 	return op.desc
 }
@@ -162,6 +167,10 @@ func (op editOperationType) getDesc() string {
 	return op.desc
 }
 
+func (op moveOperationType) getDesc() string {
+	return op.desc
+}
+
 func (op styleOperationType) run(t *testing.T, doc *document.Document, user int, ranges twoRangesType) {
 	interval := getRange(ranges, op.selector, user)
 	from, to := interval.from, interval.to
@@ -198,6 +207,13 @@ func (op editOperationType) run(t *testing.T, doc *document.Document, user int,
 	}))
 }
 
+func (op moveOperationType) run(t *testing.T, doc *document.Document, user int, ranges twoRangesType) {
+	assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+		root.GetTree("t").Move(op.fromPath, op.toPath)
+		return nil
+	}))
+}
+
 // testDesc: description of test set
 // initialState, initialXML: initial state of document
 // rangeArr: ranges to perform operation
@@ -523,3 +539,82 @@ func TestTreeConcurrencyEditStyle(t *testing.T) {
 
 	RunTestTreeConcurrency("concurrently-edit-style-test", t, initialState, initialXML, ranges, editOperations, styleOperations)
 }
+
+func TestTreeConcurrencyMoveMove(t *testing.T) {
+	//       0   1 2    3   4 5    6   7 8    9
+	// <root> <p> a </p> <p> b </p> <p> c </p> </root>
+
+	initialState := json.TreeNode{
+		Type: "root",
+		Children: []json.TreeNode{
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+		},
+	}
+	initialXML := `<root><p>a</p><p>b</p><p>c</p></root>`
+
+	ranges := []twoRangesType{
+		// moves do not use ranges.
+		makeTwoRanges(0, 3, 9, 0, 3, 9, `paths`),
+	}
+
+	moveOperations1 := []operationInterface{
+		moveOperationType{[]int{0}, []int{3}, `move-first-to-back`},
+		moveOperationType{[]int{0}, []int{2}, `move-first-to-middle`},
+		moveOperationType{[]int{2}, []int{0}, `move-last-to-front`},
+		moveOperationType{[]int{0}, []int{1, 1}, `move-first-into-middle`},
+	}
+
+	moveOperations2 := []operationInterface{
+		moveOperationType{[]int{0}, []int{3}, `move-first-to-back`},
+		moveOperationType{[]int{0}, []int{2}, `move-first-to-middle`},
+		moveOperationType{[]int{1}, []int{0}, `move-middle-to-front`},
+		moveOperationType{[]int{0}, []int{2, 1}, `move-first-into-last`},
+	}
+
+	RunTestTreeConcurrency("concurrently-move-move-test", t, initialState, initialXML, ranges, moveOperations1, moveOperations2)
+}
+
+func TestTreeConcurrencyMoveEdit(t *testing.T) {
+	//       0   1 2    3   4 5    6   7 8    9
+	// <root> <p> a </p> <p> b </p> <p> c </p> </root>
+	// 0,3 : |----------|
+	// 3,6 :            |----------|
+	// 6,9 :                       |----------|
+
+	initialState := json.TreeNode{
+		Type: "root",
+		Children: []json.TreeNode{
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+			{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+		},
+	}
+	initialXML := `<root><p>a</p><p>b</p><p>c</p></root>`
+
+	content := &json.TreeNode{Type: "p", Children: []json.TreeNode{{Type: "text", Value: `d`}}}
+
+	ranges := []twoRangesType{
+		// equal: <p>a</p> - <p>a</p>
+		makeTwoRanges(0, 3, 3, 0, 3, 3, `equal`),
+		// A -> B: <p>a</p> - <p>b</p>
+		makeTwoRanges(0, 3, 3, 3, 6, 6, `A -> B`),
+		// B -> A: <p>b</p> - <p>a</p>
+		makeTwoRanges(3, 6, 6, 0, 3, 3, `B -> A`),
+	}
+
+	moveOperations := []operationInterface{
+		moveOperationType{[]int{0}, []int{3}, `move-first-to-back`},
+		moveOperationType{[]int{2}, []int{0}, `move-last-to-front`},
+	}
+
+	editOperations := []operationInterface{
+		editOperationType{RangeFront, EditUpdate, content, 0, `insertFront`},
+		editOperationType{RangeBack, EditUpdate, content, 0, `insertBack`},
+		editOperationType{RangeAll, EditUpdate, nil, 0, `delete`},
+		editOperationType{RangeAll, EditUpdate, content, 0, `replace`},
+	}
+
+	RunTestTreeConcurrency("concurrently-move-edit-test", t, initialState, initialXML, ranges, moveOperations, editOperations)
+}
@@ -86,6 +86,8 @@ var (
 	EventWebhookCacheTTL        = 10 * gotime.Second
 	ProjectCacheSize            = 256
 	ProjectCacheTTL             = 5 * gotime.Second
+	DocCacheSize                = 128
+	DocCacheTTL                 = 5 * gotime.Second
 
 	MongoConnectionURI     = "mongodb://localhost:27017"
 	MongoConnectionTimeout = "5s"
@@ -289,6 +291,8 @@ func TestConfig() *server.Config {
 			EventWebhookRequestTimeout:  EventWebhookRequestTimeout.String(),
 			ProjectCacheSize:            ProjectCacheSize,
 			ProjectCacheTTL:             ProjectCacheTTL.String(),
+			DocCacheSize:                DocCacheSize,
+			DocCacheTTL:                 DocCacheTTL.String(),
 			GatewayAddr:                 fmt.Sprintf("localhost:%d", RPCPort+portOffset),
 		},
 		Mongo: &mongo.Config{
@@ -23,6 +23,7 @@ import (
 	"io"
 	"sync"
 	"testing"
+	gotime "time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
@@ -30,6 +31,7 @@ import (
 	"github.com/yorkie-team/yorkie/admin"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/crdt"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -148,6 +150,237 @@ func TestAdmin(t *testing.T) {
 		assert.Equal(t, document.StatusDetached, doc.Status())
 	})
 
+	t.Run("get document at revision test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. c1 attaches d1 and updates it twice.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		seq := d1.Checkpoint().ServerSeq
+		gotime.Sleep(10 * gotime.Millisecond)
+		at := gotime.Now()
+		gotime.Sleep(10 * gotime.Millisecond)
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v2")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 02. get the document at the server sequence and the time.
+		revision, err := adminCli.GetDocumentAtRevision(ctx, "default", d1.Key(), seq, gotime.Time{})
+		assert.NoError(t, err)
+		assert.Equal(t, seq, revision.ServerSeq)
+		assert.Equal(t, `{"k1":"v1"}`, revision.Root)
+		assert.Contains(t, revision.Presences, c1.ID().String())
+
+		revision, err = adminCli.GetDocumentAtRevision(ctx, "default", d1.Key(), 0, at)
+		assert.NoError(t, err)
+		assert.Equal(t, seq, revision.ServerSeq)
+		assert.Equal(t, `{"k1":"v1"}`, revision.Root)
+
+		revision, err = adminCli.GetDocumentAtRevision(ctx, "default", d1.Key(), 0, gotime.Now())
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v2"}`, revision.Root)
+
+		// 03. get the document at the server sequence that does not exist.
+		_, err = adminCli.GetDocumentAtRevision(ctx, "default", d1.Key(), seq+100, gotime.Time{})
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		assert.NoError(t, c1.Detach(ctx, d1))
+	})
+
+	t.Run("diff document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. c1 attaches d1 and updates it twice.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			root.SetNewText("k2").Edit(0, 0, "ab")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		fromSeq := d1.Checkpoint().ServerSeq
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("k1")
+			root.GetText("k2").Edit(1, 1, "c")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		toSeq := d1.Checkpoint().ServerSeq
+
+		// 02. diff the document between the two revisions.
+		diffs, err := adminCli.DiffDocument(ctx, "default", d1.Key(), fromSeq, toSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, []*crdt.Difference{
+			{Type: crdt.DiffRemove, Path: "$.k1", From: `"v1"`, Author: c1.ID().String()},
+			{Type: crdt.DiffInsert, Path: "$.k2", Index: 1, Content: "c", Author: c1.ID().String()},
+		}, diffs)
+
+		// 03. diff the document with the server sequence that does not exist.
+		_, err = adminCli.DiffDocument(ctx, "default", d1.Key(), fromSeq, toSeq+100)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		assert.NoError(t, c1.Detach(ctx, d1))
+	})
+
+	t.Run("revision tag test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. c1 attaches d1 and updates it.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		serverSeq := d1.Checkpoint().ServerSeq
+
+		// 02. create a tag of the revision.
+		tag, err := adminCli.CreateRevisionTag(ctx, "default", d1.Key(), "v1", serverSeq, "first")
+		assert.NoError(t, err)
+		assert.Equal(t, "v1", tag.Name)
+		assert.Equal(t, serverSeq, tag.ServerSeq)
+		assert.Equal(t, "first", tag.Message)
+
+		// 03. create a tag with the name that already exists.
+		_, err = adminCli.CreateRevisionTag(ctx, "default", d1.Key(), "v1", serverSeq, "")
+		assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+		// 04. create a tag of the revision that does not exist.
+		_, err = adminCli.CreateRevisionTag(ctx, "default", d1.Key(), "v2", serverSeq+100, "")
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+
+		// 05. list and delete the tags.
+		tags, err := adminCli.ListRevisionTags(ctx, "default", d1.Key())
+		assert.NoError(t, err)
+		assert.Len(t, tags, 1)
+		assert.Equal(t, "v1", tags[0].Name)
+
+		assert.NoError(t, adminCli.DeleteRevisionTag(ctx, "default", d1.Key(), "v1"))
+		err = adminCli.DeleteRevisionTag(ctx, "default", d1.Key(), "v1")
+		assert.Equal(t, connect.CodeNotFound, connect.CodeOf(err))
+
+		assert.NoError(t, c1.Detach(ctx, d1))
+	})
+
+	t.Run("fork and restore document test", func(t *testing.T) {
+		ctx := context.Background()
+
+		// 01. c1 attaches d1 and updates it twice.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			root.SetNewArray("k2").AddInteger(1, 2)
+			root.SetNewText("k3").Edit(0, 0, "ab")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		serverSeq := d1.Checkpoint().ServerSeq
+		expected := d1.Marshal()
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("k1")
+			root.GetArray("k2").AddInteger(3)
+			root.GetText("k3").Edit(0, 2, "cd")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 02. fork d1 at the revision into a new document.
+		forkKey := helper.TestDocKey(t, 1)
+		summary, err := adminCli.ForkDocument(ctx, "default", d1.Key(), serverSeq, "", forkKey)
+		assert.NoError(t, err)
+		assert.Equal(t, forkKey, summary.Key)
+		assert.Equal(t, expected, summary.Snapshot)
+
+		_, err = adminCli.ForkDocument(ctx, "default", d1.Key(), serverSeq, "", forkKey)
+		assert.Equal(t, connect.CodeAlreadyExists, connect.CodeOf(err))
+
+		d2 := document.New(forkKey)
+		assert.NoError(t, c1.Attach(ctx, d2))
+		assert.Equal(t, expected, d2.Marshal())
+		assert.NoError(t, c1.Detach(ctx, d2))
+
+		// 03. restore d1 to the revision, then c1 converges without re-attaching.
+		summary, err = adminCli.RestoreDocument(ctx, "default", d1.Key(), serverSeq)
+		assert.NoError(t, err)
+		assert.Equal(t, expected, summary.Snapshot)
+
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, expected, d1.Marshal())
+
+		// 04. c1 can keep editing the restored document.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetArray("k2").AddInteger(4)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.Equal(t, `{"k1":"v1","k2":[1,2,4],"k3":[{"val":"ab"}]}`, d1.Marshal())
+
+		assert.NoError(t, c1.Detach(ctx, d1))
+	})
+
+	t.Run("blame document test", func(t *testing.T) {
+		ctx := context.Background()
+		others := activeClients(t, 1)
+		c2 := others[0]
+		defer deactivateAndCloseClients(t, others)
+
+		// 01. c1 creates the elements, and c2 edits the text and increases
+		// the counter.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			root.SetNewText("k2").Edit(0, 0, "ab")
+			root.SetNewCounter("k3", crdt.IntegerCnt, 0)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetText("k2").Edit(1, 1, "c")
+			root.GetCounter("k3").Increase(1)
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+
+		// 02. blame the document.
+		attrs, err := adminCli.BlameDocument(ctx, "default", d1.Key())
+		assert.NoError(t, err)
+
+		var authors []string
+		for _, attr := range attrs {
+			authors = append(authors, attr.Path+" "+attr.Content+" "+attr.Author)
+			assert.NotZero(t, attr.ServerSeq)
+			assert.False(t, attr.UpdatedAt.IsZero())
+		}
+		assert.Equal(t, []string{
+			"$.k1  " + c1.ID().String(),
+			"$.k2  " + c1.ID().String(),
+			"$.k2 a " + c1.ID().String(),
+			"$.k2 c " + c2.ID().String(),
+			"$.k2 b " + c1.ID().String(),
+			"$.k3  " + c2.ID().String(),
+		}, authors)
+
+		assert.NoError(t, c1.Detach(ctx, d1))
+		assert.NoError(t, c2.Detach(ctx, d2))
+	})
+
 	t.Run("unauthentication test", func(t *testing.T) {
 		// 01. try to call admin API without token.
 		cli, err := admin.Dial(defaultServer.RPCAddr(), admin.WithInsecure(true))
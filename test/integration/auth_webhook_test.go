@@ -37,6 +37,7 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/pkg/webhook"
 	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/packs"
 	"github.com/yorkie-team/yorkie/server/rpc/auth"
 	"github.com/yorkie-team/yorkie/server/rpc/connecthelper"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -668,3 +669,128 @@ func TestAuthWebhookNewToken(t *testing.T) {
 		assert.NoError(t, cli.Activate(ctx))
 	})
 }
+
+func TestAuthWebhookPathRules(t *testing.T) {
+	t.Run("reject changes writing to not allowed path test", func(t *testing.T) {
+		ctx := context.Background()
+		authServer := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			req, err := types.NewAuthWebhookRequest(r.Body)
+			assert.NoError(t, err)
+
+			res := types.AuthWebhookResponse{Allowed: true}
+			for _, attr := range req.Attributes {
+				attr.Rules = []types.PathRule{
+					{Path: "$.settings", Verb: types.Read},
+					{Path: `$["a.b"]`, Verb: types.Read},
+				}
+				res.Attributes = append(res.Attributes, attr)
+			}
+
+			w.WriteHeader(http.StatusOK)
+			_, err = res.Write(w)
+			assert.NoError(t, err)
+		}))
+		defer authServer.Close()
+
+		svr, err := server.New(helper.TestConfig())
+		assert.NoError(t, err)
+		assert.NoError(t, svr.Start())
+		defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+		adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+		defer func() { adminCli.Close() }()
+		project, err := adminCli.CreateProject(ctx, "auth-path-rules")
+		assert.NoError(t, err)
+		project.AuthWebhookURL = authServer.URL
+		_, err = adminCli.UpdateProject(
+			ctx,
+			project.ID.String(),
+			&types.UpdatableProjectFields{
+				AuthWebhookURL:     &project.AuthWebhookURL,
+				AuthWebhookMethods: allWebhookMethods,
+			},
+		)
+		assert.NoError(t, err)
+
+		cli, err := client.Dial(
+			svr.RPCAddr(),
+			client.WithAPIKey(project.PublicKey),
+			client.WithToken("token"),
+		)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli.Close()) }()
+		assert.NoError(t, cli.Activate(ctx))
+
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		// 01. writing to the allowed path is accepted.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos").AddString("a")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 02. writing to the read-only path is rejected.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("settings").SetString("theme", "dark")
+			return nil
+		}))
+		err = cli.Sync(ctx)
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+		assert.Equal(t, connecthelper.CodeOf(packs.ErrPathNotWritable), converter.ErrorCodeOf(err))
+
+		// 03. the paths are compared by the keys, not by the strings.
+		doc2 := document.New(helper.TestDocKey(t, 2))
+		assert.NoError(t, cli.Attach(ctx, doc2))
+		assert.NoError(t, doc2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("settings.theme", "dark")
+			root.SetNewObject("a").SetString("b", "x")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx, client.WithDocKey(doc2.Key())))
+
+		// 04. writing to the read-only key with special characters is rejected.
+		doc3 := document.New(helper.TestDocKey(t, 3))
+		assert.NoError(t, cli.Attach(ctx, doc3))
+		assert.NoError(t, doc3.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewObject("a.b").SetString("c", "x")
+			return nil
+		}))
+		err = cli.Sync(ctx, client.WithDocKey(doc3.Key()))
+		assert.Equal(t, connect.CodePermissionDenied, connect.CodeOf(err))
+
+		// 05. the concurrent removes of the same element are accepted even
+		// though the later one has no effect.
+		cli2, err := client.Dial(
+			svr.RPCAddr(),
+			client.WithAPIKey(project.PublicKey),
+			client.WithToken("token"),
+		)
+		assert.NoError(t, err)
+		defer func() { assert.NoError(t, cli2.Close()) }()
+		assert.NoError(t, cli2.Activate(ctx))
+
+		doc4 := document.New(helper.TestDocKey(t, 4))
+		assert.NoError(t, cli.Attach(ctx, doc4))
+		assert.NoError(t, doc4.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewArray("todos").AddString("a", "b")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx, client.WithDocKey(doc4.Key())))
+		doc5 := document.New(helper.TestDocKey(t, 4))
+		assert.NoError(t, cli2.Attach(ctx, doc5))
+
+		for _, d := range []*document.Document{doc4, doc5} {
+			assert.NoError(t, d.Update(func(root *json.Object, p *presence.Presence) error {
+				root.GetArray("todos").Delete(0)
+				return nil
+			}))
+		}
+		assert.NoError(t, cli.Sync(ctx, client.WithDocKey(doc4.Key())))
+		assert.NoError(t, cli2.Sync(ctx, client.WithDocKey(doc5.Key())))
+		assert.NoError(t, cli.Sync(ctx, client.WithDocKey(doc4.Key())))
+		assert.Equal(t, `{"todos":["b"]}`, doc4.Marshal())
+		assert.Equal(t, doc4.Marshal(), doc5.Marshal())
+	})
+}
@@ -22,6 +22,7 @@ import (
 	"context"
 	"sync"
 	"testing"
+	"time"
 
 	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
@@ -30,6 +31,7 @@ import (
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
 	"github.com/yorkie-team/yorkie/pkg/document/crdt"
+	"github.com/yorkie-team/yorkie/pkg/document/innerpresence"
 	"github.com/yorkie-team/yorkie/pkg/document/json"
 	"github.com/yorkie-team/yorkie/pkg/document/presence"
 	"github.com/yorkie-team/yorkie/test/helper"
@@ -206,4 +208,152 @@ func TestClient(t *testing.T) {
 
 		wg.Wait()
 	})
+
+	t.Run("resume with local store test", func(t *testing.T) {
+		ctx := context.Background()
+		store, err := client.NewFileStore(t.TempDir())
+		assert.NoError(t, err)
+
+		// 01. c1 attaches the document and makes a change without sync.
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithStore(store))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			p.Set("name", "old")
+			return nil
+		}))
+		assert.Eventually(t, func() bool {
+			state, err := store.LoadDocument(d1.Key())
+			return err == nil && state != nil && len(state.Local.LocalChanges) == 1
+		}, time.Second, 10*time.Millisecond)
+
+		// 02. c1 is re-opened with the same store and resumes the document.
+		c1, err = client.Dial(defaultServer.RPCAddr(), client.WithStore(store))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		assert.Equal(t, d1.ActorID().String(), c1.ID().String())
+		resumed := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, resumed, client.WithPresence(innerpresence.Presence{"name": "new"})))
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, resumed.Marshal())
+		assert.Equal(t, innerpresence.Presence{"name": "new"}, resumed.MyPresence())
+		assert.False(t, resumed.HasLocalChanges())
+
+		// 03. c2 receives the change made before restart.
+		c2, err := client.Dial(defaultServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c1, c2})
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, `{"k1":"v1","k2":"v2"}`, d2.Marshal())
+		assert.Equal(t, innerpresence.Presence{"name": "new"}, d2.PresenceForTest(c1.ID().String()))
+
+		// 04. The state is deleted from the store after detach.
+		assert.NoError(t, c1.Detach(ctx, resumed))
+		state, err := store.LoadDocument(resumed.Key())
+		assert.NoError(t, err)
+		assert.Nil(t, state)
+	})
+
+	t.Run("discard state stored by other actor test", func(t *testing.T) {
+		ctx := context.Background()
+		store, err := client.NewFileStore(t.TempDir())
+		assert.NoError(t, err)
+
+		// 01. c1 attaches the document and closes after a change without sync.
+		c1, err := client.Dial(defaultServer.RPCAddr(), client.WithStore(store))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, c1.Close())
+
+		// the change waiting for the debounce is persisted on close
+		state, err := store.LoadDocument(d1.Key())
+		assert.NoError(t, err)
+		assert.Len(t, state.Local.LocalChanges, 1)
+
+		// 02. c2 of another key discards the state and attaches freshly.
+		c2, err := client.Dial(
+			defaultServer.RPCAddr(),
+			client.WithStore(store),
+			client.WithKey("other"),
+		)
+		assert.NoError(t, err)
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c2})
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.Equal(t, `{}`, d2.Marshal())
+
+		state, err = store.LoadDocument(d2.Key())
+		assert.NoError(t, err)
+		assert.Equal(t, c2.ID().String(), state.Local.ChangeID.ActorID().String())
+	})
+
+	t.Run("auto sync test", func(t *testing.T) {
+		ctx := context.Background()
+		clients := activeClients(t, 2)
+		defer deactivateAndCloseClients(t, clients)
+		c1, c2 := clients[0], clients[1]
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithAutoSync()))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2, client.WithAutoSync()))
+		events1, err := c1.SyncEvents(d1)
+		assert.NoError(t, err)
+		events2, err := c2.SyncEvents(d2)
+		assert.NoError(t, err)
+
+		waitUntil := func(events <-chan client.SyncEvent, cond func() bool) {
+			for !cond() {
+				select {
+				case e := <-events:
+					assert.Equal(t, client.SyncStatusSynced, e.Status)
+				case <-time.After(5 * time.Second):
+					assert.Fail(t, "sync timeout")
+					return
+				}
+			}
+		}
+
+		// 01. The local change of d1 is pushed and pulled by d2 in background.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		waitUntil(events2, func() bool { return d2.Marshal() == `{"k1":"v1"}` })
+
+		// 02. d2 does not pull the changes while the sync is paused.
+		assert.NoError(t, c2.ChangeSyncMode(d2, client.SyncModeManual))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		waitUntil(events1, func() bool { return !d1.HasLocalChanges() })
+		time.Sleep(300 * time.Millisecond)
+		assert.Equal(t, `{"k1":"v1"}`, d2.Marshal())
+
+		// 03. d2 pulls the changes after the sync is resumed.
+		assert.NoError(t, c2.ChangeSyncMode(d2, client.SyncModeRealtime))
+		waitUntil(events2, func() bool { return d2.Marshal() == `{"k1":"v1","k2":"v2"}` })
+
+		// 04. The sync mode of the document without auto sync cannot be changed.
+		d3 := document.New(helper.TestDocKey(t) + "-3")
+		assert.NoError(t, c1.Attach(ctx, d3))
+		assert.ErrorIs(t, c1.ChangeSyncMode(d3, client.SyncModeManual), client.ErrAutoSyncDisabled)
+	})
 }
@@ -221,6 +221,73 @@ func TestDocument(t *testing.T) {
 		assert.Equal(t, d1.Marshal(), d2.Marshal())
 	})
 
+	t.Run("watch local and remote change events test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t), document.WithChangeEvents())
+		assert.NoError(t, c1.Attach(ctx, d1, client.WithRealtimeSync()))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2, client.WithRealtimeSync()))
+
+		rch, _, err := c1.Subscribe(d1)
+		assert.NoError(t, err)
+
+		wg := sync.WaitGroup{}
+		wg.Add(1)
+		var changes []client.WatchResponse
+		go func() {
+			defer wg.Done()
+
+			for {
+				resp := <-rch
+				if resp.Err == io.EOF {
+					assert.Fail(t, resp.Err.Error())
+					return
+				}
+				assert.NoError(t, resp.Err)
+
+				switch resp.Type {
+				case client.DocumentChanged:
+					assert.NoError(t, c1.Sync(ctx, client.WithDocKey(d1.Key())))
+				case client.LocalChanged:
+					// NOTE: The presence initialized by Attach also comes as
+					// a local change without operations.
+					if len(resp.Change.Operations) > 0 {
+						changes = append(changes, resp)
+					}
+				case client.RemoteChanged:
+					// NOTE: Skip the changes of the other runs pulled by Attach.
+					if resp.Change.ActorID.Compare(c2.ID()) != 0 {
+						continue
+					}
+					changes = append(changes, resp)
+					return
+				}
+			}
+		}()
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v1")
+			return nil
+		}))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k2", "v2")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+
+		wg.Wait()
+
+		assert.Len(t, changes, 2)
+		assert.Equal(t, client.LocalChanged, changes[0].Type)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationSet, Path: "$", Key: "k1"},
+		}, changes[0].Change.Operations)
+		assert.Equal(t, client.RemoteChanged, changes[1].Type)
+		assert.Equal(t, []document.OperationInfo{
+			{Type: document.OperationSet, Path: "$", Key: "k2"},
+		}, changes[1].Change.Operations)
+	})
+
 	t.Run("document tombstone test", func(t *testing.T) {
 		ctx := context.Background()
 
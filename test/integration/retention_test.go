@@ -23,10 +23,13 @@ import (
 	"log"
 	"reflect"
 	"testing"
+	gotime "time"
 
+	"connectrpc.com/connect"
 	"github.com/stretchr/testify/assert"
 	monkey "github.com/undefinedlabs/go-mpatch"
 
+	"github.com/yorkie-team/yorkie/api/converter"
 	"github.com/yorkie-team/yorkie/client"
 	"github.com/yorkie-team/yorkie/pkg/document"
 	"github.com/yorkie-team/yorkie/pkg/document/change"
@@ -35,7 +38,9 @@ import (
 	"github.com/yorkie-team/yorkie/server"
 	"github.com/yorkie-team/yorkie/server/backend/background"
 	"github.com/yorkie-team/yorkie/server/backend/database/mongo"
+	"github.com/yorkie-team/yorkie/server/documents"
 	"github.com/yorkie-team/yorkie/server/logging"
+	"github.com/yorkie-team/yorkie/server/rpc/connecthelper"
 	"github.com/yorkie-team/yorkie/test/helper"
 )
 
@@ -118,6 +123,77 @@ func TestRetention(t *testing.T) {
 		assert.Len(t, changes2, 0)
 	})
 
+	t.Run("get document at purged revision test", func(t *testing.T) {
+		conf := helper.TestConfig()
+		conf.Backend.SnapshotWithPurgingChanges = true
+		conf.Backend.SnapshotInterval = 0
+		testServer, err := server.New(conf)
+		if err != nil {
+			log.Fatal(err)
+		}
+
+		if err := testServer.Start(); err != nil {
+			logging.DefaultLogger().Fatal(err)
+		}
+
+		cli, err := client.Dial(testServer.RPCAddr())
+		assert.NoError(t, err)
+		assert.NoError(t, cli.Activate(context.Background()))
+		defer func() {
+			assert.NoError(t, cli.Deactivate(context.Background()))
+			assert.NoError(t, cli.Close())
+		}()
+
+		adminCli := helper.CreateAdminCli(t, testServer.RPCAddr())
+		defer func() { adminCli.Close() }()
+
+		ctx := context.Background()
+
+		// 01. Update the document and purge the changes with the snapshot.
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		defer func() { assert.NoError(t, cli.Detach(ctx, doc)) }()
+
+		for _, v := range []string{"v1", "v2"} {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString("k1", v)
+				return nil
+			}))
+		}
+		assert.NoError(t, cli.Sync(ctx))
+
+		// NOTE: The snapshot is created only after the last pushed change, so
+		// the revision of the first change is built from the changes only.
+		oldSeq := doc.Checkpoint().ServerSeq - 1
+
+		// NOTE: The changes synced by the client are purged when the next
+		// snapshot is created.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("k1", "v3")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+
+		// 02. The revision of the purged changes cannot be built.
+		_, err = adminCli.GetDocumentAtRevision(ctx, "default", doc.Key(), oldSeq, gotime.Time{})
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		assert.Equal(t, connecthelper.CodeOf(documents.ErrRevisionPurged), converter.ErrorCodeOf(err))
+
+		_, err = adminCli.DiffDocument(ctx, "default", doc.Key(), oldSeq, doc.Checkpoint().ServerSeq)
+		assert.Equal(t, connecthelper.CodeOf(documents.ErrRevisionPurged), converter.ErrorCodeOf(err))
+
+		// 03. The revision of the snapshot can still be built.
+		revision, err := adminCli.GetDocumentAtRevision(
+			ctx,
+			"default",
+			doc.Key(),
+			doc.Checkpoint().ServerSeq,
+			gotime.Time{},
+		)
+		assert.NoError(t, err)
+		assert.Equal(t, `{"k1":"v3"}`, revision.Root)
+	})
+
 	t.Run("snapshot with purging changes test", func(t *testing.T) {
 		serverConfig := helper.TestConfig()
 		// Default SnapshotInterval is 0, SnapshotThreshold must also be 0
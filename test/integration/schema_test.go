@@ -0,0 +1,254 @@
+//go:build integration
+
+/*
+ * Copyright 2025 The Yorkie Authors. All rights reserved.
+ *
+ * Licensed under the Apache License, Version 2.0 (the "License");
+ * you may not use this file except in compliance with the License.
+ * You may obtain a copy of the License at
+ *
+ *     http://www.apache.org/licenses/LICENSE-2.0
+ *
+ * Unless required by applicable law or agreed to in writing, software
+ * distributed under the License is distributed on an "AS IS" BASIS,
+ * WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+ * See the License for the specific language governing permissions and
+ * limitations under the License.
+ */
+
+package integration
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"connectrpc.com/connect"
+	"github.com/stretchr/testify/assert"
+
+	"github.com/yorkie-team/yorkie/api/converter"
+	"github.com/yorkie-team/yorkie/api/types"
+	"github.com/yorkie-team/yorkie/client"
+	"github.com/yorkie-team/yorkie/pkg/document"
+	"github.com/yorkie-team/yorkie/pkg/document/json"
+	"github.com/yorkie-team/yorkie/pkg/document/presence"
+	"github.com/yorkie-team/yorkie/pkg/schema"
+	"github.com/yorkie-team/yorkie/server"
+	"github.com/yorkie-team/yorkie/server/rpc/connecthelper"
+	"github.com/yorkie-team/yorkie/test/helper"
+)
+
+func TestDocumentSchema(t *testing.T) {
+	svr, err := server.New(helper.TestConfig())
+	assert.NoError(t, err)
+	assert.NoError(t, svr.Start())
+	defer func() { assert.NoError(t, svr.Shutdown(true)) }()
+
+	adminCli := helper.CreateAdminCli(t, svr.RPCAddr())
+	defer func() { adminCli.Close() }()
+
+	ctx := context.Background()
+	project, err := adminCli.CreateProject(ctx, "document-schema-test")
+	assert.NoError(t, err)
+
+	documentSchema := `{"type":"object","properties":{"title":{"type":"string"}}}`
+	_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+		DocumentSchema: &documentSchema,
+	})
+	assert.NoError(t, err)
+
+	cli, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+	assert.NoError(t, err)
+	defer func() { assert.NoError(t, cli.Close()) }()
+	assert.NoError(t, cli.Activate(ctx))
+	defer func() { assert.NoError(t, cli.Deactivate(ctx)) }()
+
+	t.Run("update project with invalid schema test", func(t *testing.T) {
+		invalidSchema := `{"type":"array"}`
+		_, err := adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			DocumentSchema: &invalidSchema,
+		})
+		assert.Equal(t, connect.CodeInvalidArgument, connect.CodeOf(err))
+		assert.Equal(t, connecthelper.CodeOf(schema.ErrInvalidSchema), converter.ErrorCodeOf(err))
+	})
+
+	t.Run("push changes conforming to schema test", func(t *testing.T) {
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+		defer func() { assert.NoError(t, cli.Detach(ctx, doc)) }()
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "hello")
+			return nil
+		}))
+		assert.NoError(t, cli.Sync(ctx))
+	})
+
+	t.Run("validate consecutive pushes with cached document test", func(t *testing.T) {
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		for _, title := range []string{"hello", "world"} {
+			assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+				root.SetString("title", title)
+				return nil
+			}))
+			assert.NoError(t, cli.Sync(ctx))
+		}
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("title", 1)
+			return nil
+		}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(cli.Sync(ctx)))
+	})
+
+	t.Run("reject changes violating schema test", func(t *testing.T) {
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, cli.Attach(ctx, doc))
+
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("title", 1)
+			return nil
+		}))
+		err := cli.Sync(ctx)
+		assert.Error(t, err)
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(err))
+		assert.Equal(t, connecthelper.CodeOf(schema.ErrSchemaViolation), converter.ErrorCodeOf(err))
+	})
+
+	t.Run("stop auto sync on schema violation test", func(t *testing.T) {
+		c1, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c1})
+
+		doc := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, doc, client.WithAutoSync()))
+		events, err := c1.SyncEvents(doc)
+		assert.NoError(t, err)
+
+		waitFor := func(status client.SyncStatus) client.SyncEvent {
+			for {
+				select {
+				case e := <-events:
+					if e.Status == status {
+						return e
+					}
+				case <-time.After(5 * time.Second):
+					assert.Fail(t, "sync timeout")
+					return client.SyncEvent{}
+				}
+			}
+		}
+
+		// 01. the sync is stopped instead of retried when the pushed changes
+		// violate the schema.
+		assert.NoError(t, doc.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("title", 1)
+			return nil
+		}))
+		e := waitFor(client.SyncStatusStopped)
+		assert.Equal(t, connecthelper.CodeOf(schema.ErrSchemaViolation), converter.ErrorCodeOf(e.Err))
+
+		select {
+		case e := <-events:
+			assert.Fail(t, "unexpected sync event", e.Status)
+		case <-time.After(1500 * time.Millisecond):
+		}
+
+		// 02. the resumed sync is stopped again while the cause remains.
+		assert.NoError(t, c1.ChangeSyncMode(doc, client.SyncModeRealtime))
+		waitFor(client.SyncStatusStopped)
+		assert.True(t, doc.HasLocalChanges())
+	})
+
+	t.Run("attach new document with required properties test", func(t *testing.T) {
+		project, err := adminCli.CreateProject(ctx, "document-schema-required")
+		assert.NoError(t, err)
+		requiredSchema := `{"type":"object","properties":{"title":{"type":"string"}},"required":["title"]}`
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			DocumentSchema: &requiredSchema,
+		})
+		assert.NoError(t, err)
+
+		c1, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		c2, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		assert.NoError(t, c2.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c1, c2})
+
+		// 01. the empty document can be attached, and is validated once the
+		// first change with operations is pushed.
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Set("name", "c1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "hello")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		// 02. the changes without validation are applied to the cached
+		// document before validating the next push.
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Set("name", "c2")
+			return nil
+		}))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetString("title", "world")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c2.Sync(ctx))
+		assert.Equal(t, `{"title":"world"}`, d2.Marshal())
+
+		// 03. the change removing the required property is rejected.
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.Delete("title")
+			return nil
+		}))
+		assert.Equal(t, connect.CodeFailedPrecondition, connect.CodeOf(c2.Sync(ctx)))
+	})
+
+	t.Run("detach document not conforming to schema added later test", func(t *testing.T) {
+		project, err := adminCli.CreateProject(ctx, "document-schema-later")
+		assert.NoError(t, err)
+
+		c1, err := client.Dial(svr.RPCAddr(), client.WithAPIKey(project.PublicKey))
+		assert.NoError(t, err)
+		assert.NoError(t, c1.Activate(ctx))
+		defer deactivateAndCloseClients(t, []*client.Client{c1})
+
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetInteger("title", 1)
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+
+		_, err = adminCli.UpdateProject(ctx, project.ID.String(), &types.UpdatableProjectFields{
+			DocumentSchema: &documentSchema,
+		})
+		assert.NoError(t, err)
+
+		// NOTE: The presence changes do not modify the root, so they are
+		// accepted even though the document does not conform to the schema.
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			p.Set("name", "c1")
+			return nil
+		}))
+		assert.NoError(t, c1.Sync(ctx))
+		assert.NoError(t, c1.Detach(ctx, d1))
+	})
+}
@@ -20,6 +20,7 @@ package integration
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	"github.com/stretchr/testify/assert"
@@ -3887,4 +3888,114 @@ func TestTree(t *testing.T) {
 		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
 		assert.Equal(t, "<root><p>ab</p><p>ced</p></root>", d1.Root().GetTree("t").ToXML())
 	})
+
+	t.Run("concurrently move the same node to different positions test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{
+				Type: "r",
+				Children: []json.TreeNode{
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "c"}}},
+				},
+			})
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{3})
+			return nil
+		}))
+		assert.Equal(t, "<r><p>b</p><p>c</p><p>a</p></r>", d1.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{2})
+			return nil
+		}))
+		assert.Equal(t, "<r><p>b</p><p>a</p><p>c</p></r>", d2.Root().GetTree("t").ToXML())
+
+		// NOTE: The node is not duplicated and the move executed last wins.
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, 1, strings.Count(d1.Root().GetTree("t").ToXML(), "<p>a</p>"))
+	})
+
+	t.Run("concurrently move a node and edit inside it test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{
+				Type: "r",
+				Children: []json.TreeNode{
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "b"}}},
+				},
+			})
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{2})
+			return nil
+		}))
+		assert.Equal(t, "<r><p>b</p><p>a</p></r>", d1.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(2, 2, &json.TreeNode{Type: "text", Value: "x"}, 0)
+			return nil
+		}))
+		assert.Equal(t, "<r><p>ax</p><p>b</p></r>", d2.Root().GetTree("t").ToXML())
+
+		// NOTE: The moved node keeps its identity, so the concurrent edit
+		// inside it is kept.
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, "<r><p>b</p><p>ax</p></r>", d1.Root().GetTree("t").ToXML())
+	})
+
+	t.Run("concurrently move a node into a deleted node test", func(t *testing.T) {
+		ctx := context.Background()
+		d1 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c1.Attach(ctx, d1))
+		d2 := document.New(helper.TestDocKey(t))
+		assert.NoError(t, c2.Attach(ctx, d2))
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.SetNewTree("t", &json.TreeNode{
+				Type: "r",
+				Children: []json.TreeNode{
+					{Type: "p", Children: []json.TreeNode{{Type: "text", Value: "a"}}},
+					{Type: "div", Children: []json.TreeNode{}},
+				},
+			})
+			return nil
+		}))
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+
+		assert.NoError(t, d1.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Move([]int{0}, []int{1, 0})
+			return nil
+		}))
+		assert.Equal(t, "<r><div><p>a</p></div></r>", d1.Root().GetTree("t").ToXML())
+
+		assert.NoError(t, d2.Update(func(root *json.Object, p *presence.Presence) error {
+			root.GetTree("t").Edit(3, 5, nil, 0)
+			return nil
+		}))
+		assert.Equal(t, "<r><p>a</p></r>", d2.Root().GetTree("t").ToXML())
+
+		// NOTE: The moved node disappears together with the deleted parent.
+		syncClientsThenAssertEqual(t, []clientAndDocPair{{c1, d1}, {c2, d2}})
+		assert.Equal(t, "<r></r>", d1.Root().GetTree("t").ToXML())
+	})
 }